@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"charm.land/log/v2"
+	"github.com/govote-sh/govote/internal/api"
+	"github.com/govote-sh/govote/internal/batch"
+)
+
+// runBatch implements `govote batch`, returning the process exit code.
+func runBatch(args []string) int {
+	flags := flag.NewFlagSet("batch", flag.ContinueOnError)
+	inPath := flags.String("in", "", "CSV of addresses with street, city, state and zip columns")
+	outPath := flags.String("out", "", "Where to write results; appended to when resuming")
+	format := flags.String("format", "", "Output format, csv or jsonl (default: from the -out extension)")
+	checkpointPath := flags.String("checkpoint", "", "Checkpoint file (default: <out>.checkpoint)")
+	workers := flags.Int("workers", 4, "Lookups to run at once")
+	rate := flags.Float64("rate", 5, "Maximum lookups per second")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: govote batch -in voters.csv -out results.csv [flags]")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *inPath == "" || *outPath == "" || *rate <= 0 {
+		flags.Usage()
+		return 2
+	}
+
+	outFormat := batch.Format(strings.ToLower(*format))
+	if outFormat == "" {
+		outFormat = batch.CSV
+		if strings.HasSuffix(strings.ToLower(*outPath), ".jsonl") {
+			outFormat = batch.JSONL
+		}
+	}
+	if outFormat != batch.CSV && outFormat != batch.JSONL {
+		log.Error("Unknown output format", "format", *format)
+		return 2
+	}
+	if *checkpointPath == "" {
+		*checkpointPath = *outPath + ".checkpoint"
+	}
+
+	in, err := os.Open(*inPath)
+	if err != nil {
+		log.Error("Failed to open input", "error", err)
+		return 1
+	}
+	defer func() { _ = in.Close() }()
+
+	out, err := os.OpenFile(*outPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		log.Error("Failed to open output", "error", err)
+		return 1
+	}
+	defer func() { _ = out.Close() }()
+	if info, err := out.Stat(); err == nil && info.Size() == 0 && outFormat == batch.CSV {
+		if err := batch.WriteCSVHeader(out); err != nil {
+			log.Error("Failed to write output", "error", err)
+			return 1
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	log.Info("Starting batch lookup", "in", *inPath, "out", *outPath, "checkpoint", *checkpointPath)
+	summary, err := batch.Run(ctx, in, out, *checkpointPath, batch.Options{
+		// Cached, so repeated addresses in a list cost one lookup
+		Provider: api.NewCachingProvider(api.CivicProvider{}, time.Hour, 10000),
+		Format:   outFormat,
+		Workers:  *workers,
+		Interval: time.Duration(float64(time.Second) / *rate),
+		Attempts: 3,
+	})
+	log.Info("Batch lookup finished", "succeeded", summary.Succeeded, "failed", summary.Failed, "skipped", summary.Skipped)
+	if errors.Is(err, context.Canceled) {
+		log.Warn("Interrupted; run the same command again to resume")
+		return 1
+	} else if err != nil {
+		log.Error("Batch lookup failed", "error", err)
+		return 1
+	}
+	return 0
+}
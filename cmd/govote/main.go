@@ -5,6 +5,7 @@ import (
 	"errors"
 	"flag"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
@@ -15,8 +16,13 @@ import (
 	"charm.land/wish/v2/bubbletea"
 	"charm.land/wish/v2/logging"
 	"github.com/charmbracelet/ssh"
+	"github.com/govote-sh/govote/internal/api"
+	"github.com/govote-sh/govote/internal/gateway"
+	"github.com/govote-sh/govote/internal/i18n"
 	"github.com/govote-sh/govote/internal/secrets"
+	"github.com/govote-sh/govote/internal/store"
 	"github.com/govote-sh/govote/internal/tui"
+	gossh "golang.org/x/crypto/ssh"
 
 	_ "golang.org/x/crypto/x509roots/fallback"
 )
@@ -45,20 +51,42 @@ func main() {
 		log.Fatal("Failed to initialize secrets", "error", err)
 	}
 
+	if len(os.Args) > 1 && os.Args[1] == "batch" {
+		os.Exit(runBatch(os.Args[2:]))
+	}
+
 	flagHostKeyPath := flag.String("keypath", ".ssh/govote", "Path to the SSH host key")
+	flagStorePath := flag.String("storepath", "", "Directory for remembered addresses (disabled if empty)")
+	flagRetention := flag.Duration("retention", 30*24*time.Hour, "How long remembered addresses are kept")
+	flagHTTPAddr := flag.String("http", "", "Address for the read-only HTTP JSON gateway, e.g. :8080 (disabled if empty)")
+	flagTrustProxy := flag.Bool("trustproxy", false, "Rate limit the HTTP gateway by the Fly-Client-IP header")
 	flag.Parse()
 	hostKeyPath := *flagHostKeyPath
 
-	srv, err := wish.NewServer(
+	st := setupStore(*flagStorePath, *flagRetention)
+	provider := api.NewCachingProvider(api.CivicProvider{}, 15*time.Minute, 1000)
+
+	options := []ssh.Option{
 		wish.WithAddress(net.JoinHostPort(host, port)),
 		wish.WithHostKeyPath(hostKeyPath),
 		wish.WithMiddleware(
-			bubbletea.Middleware(tui.TeaHandler),
+			bubbletea.Middleware(tui.NewTeaHandler(provider, st)),
+			forgetMiddleware(st),
 			logging.Middleware(),
 		),
 		wish.WithIdleTimeout(8*time.Minute),
 		wish.WithMaxTimeout(60*time.Minute),
-	)
+	}
+	if st != nil {
+		// Ask for public keys so sessions can be matched to saved addresses,
+		// but let clients without one in through keyboard-interactive.
+		options = append(options,
+			wish.WithPublicKeyAuth(func(ssh.Context, ssh.PublicKey) bool { return true }),
+			wish.WithKeyboardInteractiveAuth(func(ssh.Context, gossh.KeyboardInteractiveChallenge) bool { return true }),
+		)
+	}
+
+	srv, err := wish.NewServer(options...)
 	if err != nil {
 		log.Fatal("Failed to create SSH server", "error", err, "host", host, "port", port)
 	}
@@ -73,6 +101,27 @@ func main() {
 		}
 	}()
 
+	var httpSrv *http.Server
+	if *flagHTTPAddr != "" {
+		httpSrv = &http.Server{
+			Addr:              *flagHTTPAddr,
+			Handler:           gateway.New(provider, provider, gateway.Options{Rate: 2, Burst: 10, TrustProxy: *flagTrustProxy}),
+			ReadHeaderTimeout: 10 * time.Second,
+			WriteTimeout:      30 * time.Second,
+		}
+		log.Info("Starting HTTP gateway", "addr", httpSrv.Addr)
+		go func() {
+			if err := httpSrv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Error("HTTP gateway failed", "error", err, "addr", httpSrv.Addr)
+				done <- nil
+			}
+		}()
+	}
+
+	if st != nil {
+		go sweepStore(st)
+	}
+
 	// BUG: Timeout is not working
 	<-done
 	log.Info("Stopping SSH server")
@@ -81,4 +130,70 @@ func main() {
 	if err := srv.Shutdown(ctx); err != nil && !errors.Is(err, ssh.ErrServerClosed) {
 		log.Error("Failed to shutdown SSH server gracefully", "error", err)
 	}
+	if httpSrv != nil {
+		if err := httpSrv.Shutdown(ctx); err != nil {
+			log.Error("Failed to shutdown HTTP gateway gracefully", "error", err)
+		}
+	}
+}
+
+// setupStore opens the remembered-address store, or returns nil if it is not
+// configured. Remembering addresses needs both a directory and STORE_KEY.
+func setupStore(dir string, retention time.Duration) *store.Store {
+	if dir == "" {
+		return nil
+	}
+	key, err := secrets.GetStoreKey()
+	if errors.Is(err, secrets.ErrStoreKeyNotSet) {
+		log.Warn("Store path set but STORE_KEY is not; addresses will not be remembered")
+		return nil
+	} else if err != nil {
+		log.Fatal("Failed to load store key", "error", err)
+	}
+	st, err := store.New(dir, key, retention)
+	if err != nil {
+		log.Fatal("Failed to open address store", "error", err, "path", dir)
+	}
+	log.Info("Remembering addresses on request", "path", dir, "retention", retention)
+	return st
+}
+
+// sweepStore deletes expired addresses at startup and then hourly, so
+// entries nobody comes back for still disappear on time.
+func sweepStore(st *store.Store) {
+	for {
+		n, err := st.Sweep()
+		if err != nil {
+			log.Error("Failed to sweep address store", "error", err)
+		}
+		if n > 0 {
+			log.Info("Deleted expired addresses", "count", n)
+		}
+		time.Sleep(time.Hour)
+	}
+}
+
+// forgetMiddleware handles `ssh govote.sh forget`, deleting all of
+// the caller's saved addresses without starting the TUI. It answers in the
+// language of the caller's locale.
+func forgetMiddleware(st *store.Store) wish.Middleware {
+	return func(next ssh.Handler) ssh.Handler {
+		return func(s ssh.Session) {
+			if cmd := s.Command(); len(cmd) != 1 || cmd[0] != "forget" {
+				next(s)
+				return
+			}
+			loc := i18n.FromEnv(s.Environ())
+			if st == nil || s.PublicKey() == nil {
+				wish.Println(s, loc.T("govote.sh has no saved addresses for you."))
+				return
+			}
+			if err := st.Forget(st.UserID(s.PublicKey())); err != nil {
+				log.Error("Failed to forget saved address", "error", err)
+				wish.Fatalln(s, loc.T("Could not delete your saved addresses, please try again."))
+				return
+			}
+			wish.Println(s, loc.T("Your saved addresses have been deleted."))
+		}
+	}
 }
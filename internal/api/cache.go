@@ -0,0 +1,124 @@
+package api
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/govote-sh/govote/internal/address"
+)
+
+// CachingProvider wraps a Provider and reuses its successful responses for
+// a while, so switching back and forth between addresses doesn't query the
+// API every time. Errors are never cached.
+type CachingProvider struct {
+	next    Provider
+	ttl     time.Duration
+	size    int
+	now     func() time.Time
+	mu      sync.Mutex
+	entries map[string]*list.Element // Holding *cacheEntry, in order
+	order   *list.List               // Entries, least recently fetched first
+
+	elections        []Election
+	electionsFetched time.Time
+}
+
+type cacheEntry struct {
+	key     string
+	data    VoterInfoResponse
+	fetched time.Time
+}
+
+// NewCachingProvider caches up to size responses from next for ttl each.
+func NewCachingProvider(next Provider, ttl time.Duration, size int) *CachingProvider {
+	return &CachingProvider{
+		next:    next,
+		ttl:     ttl,
+		size:    size,
+		now:     time.Now,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+func (c *CachingProvider) VoterInfo(ctx context.Context, addr address.InputAddress) (VoterInfoResponse, error) {
+	key := cacheKey(addr)
+	if data, ok := c.get(key); ok {
+		return data, nil
+	}
+	data, err := c.next.VoterInfo(ctx, addr)
+	if err != nil {
+		return data, err
+	}
+	c.put(key, data)
+	return data, nil
+}
+
+// Elections lists elections from the wrapped provider, cached like lookups.
+// It fails if the wrapped provider can't list elections.
+func (c *CachingProvider) Elections(ctx context.Context) ([]Election, error) {
+	lister, ok := c.next.(ElectionLister)
+	if !ok {
+		return nil, errors.New("provider cannot list elections")
+	}
+
+	c.mu.Lock()
+	if c.elections != nil && c.now().Sub(c.electionsFetched) <= c.ttl {
+		elections := c.elections
+		c.mu.Unlock()
+		return elections, nil
+	}
+	c.mu.Unlock()
+
+	elections, err := lister.Elections(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if elections == nil {
+		elections = []Election{}
+	}
+	c.mu.Lock()
+	c.elections, c.electionsFetched = elections, c.now()
+	c.mu.Unlock()
+	return elections, nil
+}
+
+func (c *CachingProvider) get(key string) (VoterInfoResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		return VoterInfoResponse{}, false
+	}
+	entry := elem.Value.(*cacheEntry)
+	if c.now().Sub(entry.fetched) > c.ttl {
+		c.remove(elem)
+		return VoterInfoResponse{}, false
+	}
+	return entry.data, true
+}
+
+func (c *CachingProvider) put(key string, data VoterInfoResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	} else if c.order.Len() > 0 && c.order.Len() >= c.size {
+		// Drop the least recently fetched entry
+		c.remove(c.order.Front())
+	}
+	c.entries[key] = c.order.PushBack(&cacheEntry{key: key, data: data, fetched: c.now()})
+}
+
+func (c *CachingProvider) remove(elem *list.Element) {
+	delete(c.entries, c.order.Remove(elem).(*cacheEntry).key)
+}
+
+// cacheKey normalizes case and spacing, which the API ignores too.
+func cacheKey(addr address.InputAddress) string {
+	return strings.ToLower(strings.Join(strings.Fields(addr.String()), " "))
+}
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/govote-sh/govote/internal/address"
+	"github.com/govote-sh/govote/internal/utils"
+)
+
+// stubProvider returns err if set, and otherwise a response named after the
+// call count, so tests can tell fresh responses from cached ones.
+type stubProvider struct {
+	calls int
+	err   error
+}
+
+func (p *stubProvider) VoterInfo(context.Context, address.InputAddress) (VoterInfoResponse, error) {
+	p.calls++
+	if p.err != nil {
+		return VoterInfoResponse{}, p.err
+	}
+	return VoterInfoResponse{Election: Election{ID: string(rune('0' + p.calls))}}, nil
+}
+
+func TestCachingProvider(t *testing.T) {
+	ctx := context.Background()
+	stub := &stubProvider{}
+	c := NewCachingProvider(stub, time.Minute, 2)
+	now := time.Now()
+	c.now = func() time.Time { return now }
+
+	home := address.InputAddress{Street: "1234 W Broad St", City: "Richmond"}
+	if _, err := c.VoterInfo(ctx, home); err != nil {
+		t.Fatalf("VoterInfo: %v", err)
+	}
+	// Case and spacing differences hit the same entry
+	if _, err := c.VoterInfo(ctx, address.InputAddress{Street: "1234 w broad  st", City: "RICHMOND"}); err != nil {
+		t.Fatalf("VoterInfo: %v", err)
+	}
+	if stub.calls != 1 {
+		t.Errorf("calls = %d after a repeat lookup, want 1", stub.calls)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, err := c.VoterInfo(ctx, home); err != nil {
+		t.Fatalf("VoterInfo: %v", err)
+	}
+	if stub.calls != 2 {
+		t.Errorf("calls = %d after the entry expired, want 2", stub.calls)
+	}
+}
+
+func TestCachingProviderDoesNotCacheErrors(t *testing.T) {
+	stub := &stubProvider{err: utils.ErrMsg{Err: errors.New("boom"), HTTPStatusCode: 503}}
+	c := NewCachingProvider(stub, time.Minute, 2)
+	addr := address.InputAddress{City: "Richmond"}
+
+	for range 2 {
+		if _, err := c.VoterInfo(context.Background(), addr); err == nil {
+			t.Fatal("VoterInfo error = nil, want the provider's error")
+		}
+	}
+	if stub.calls != 2 {
+		t.Errorf("calls = %d, want 2", stub.calls)
+	}
+}
+
+func TestCachingProviderEvictsOldest(t *testing.T) {
+	stub := &stubProvider{}
+	c := NewCachingProvider(stub, time.Hour, 2)
+	now := time.Now()
+	c.now = func() time.Time { now = now.Add(time.Second); return now }
+
+	for _, city := range []string{"Richmond", "Norfolk", "Roanoke", "Norfolk"} {
+		if _, err := c.VoterInfo(context.Background(), address.InputAddress{City: city}); err != nil {
+			t.Fatalf("VoterInfo(%s): %v", city, err)
+		}
+	}
+	if stub.calls != 3 {
+		t.Errorf("calls = %d, want 3", stub.calls)
+	}
+	if _, ok := c.entries[cacheKey(address.InputAddress{City: "Richmond"})]; ok {
+		t.Error("oldest entry was not evicted")
+	}
+	if c.order.Len() != len(c.entries) {
+		t.Errorf("%d entries in eviction order, want %d", c.order.Len(), len(c.entries))
+	}
+}
@@ -17,32 +17,130 @@ import (
 	"github.com/govote-sh/govote/internal/utils"
 )
 
-const baseURL = "https://www.googleapis.com/civicinfo/v2/voterinfo"
+const (
+	baseURL      = "https://www.googleapis.com/civicinfo/v2/voterinfo"
+	electionsURL = "https://www.googleapis.com/civicinfo/v2/elections"
+)
+
+// ErrNoElectionDay is returned when the API answers but has no election for
+// the address, which usually means the address is outside any jurisdiction
+// with data loaded.
+var ErrNoElectionDay = errors.New("could not extract election day from response")
+
+// errorBody is the error envelope Google APIs return with non-200 responses.
+type errorBody struct {
+	Error struct {
+		Message string `json:"message"`
+		Errors  []struct {
+			Reason string `json:"reason"`
+		} `json:"errors"`
+	} `json:"error"`
+}
+
+// errorReason extracts the first reason from a Google API error body, or ""
+// if the body isn't in that format.
+func errorReason(body io.Reader) string {
+	var e errorBody
+	if err := json.NewDecoder(io.LimitReader(body, 1<<16)).Decode(&e); err != nil || len(e.Error.Errors) == 0 {
+		return ""
+	}
+	return e.Error.Errors[0].Reason
+}
+
+// Provider looks up election information for an address. Errors are
+// utils.ErrMsg values.
+type Provider interface {
+	VoterInfo(ctx context.Context, addr address.InputAddress) (VoterInfoResponse, error)
+}
+
+// ElectionLister lists the elections a data source knows about.
+type ElectionLister interface {
+	Elections(ctx context.Context) ([]Election, error)
+}
+
+// CivicProvider is the Provider backed by the Google Civic Information API.
+type CivicProvider struct {
+	// Client is used for requests; nil means a client with a 10s timeout
+	Client *http.Client
+}
 
+// CheckServer looks up addr with the Civic API, returning either the
+// VoterInfoResponse or a utils.ErrMsg as a tea.Msg.
 func CheckServer(addr address.InputAddress) tea.Msg {
-	c := &http.Client{Timeout: 10 * time.Second}
+	return Msg(CivicProvider{}.VoterInfo(context.Background(), addr))
+}
 
-	apiKey, err := secrets.GetAPIKey()
-	if err != nil {
-		return utils.ErrMsg{Err: err}
+// Msg turns a Provider's results into the tea.Msg the TUI expects: the
+// response, or the error as a utils.ErrMsg.
+func Msg(data VoterInfoResponse, err error) tea.Msg {
+	if err == nil {
+		return data
 	}
+	var errMsg utils.ErrMsg
+	if errors.As(err, &errMsg) {
+		return errMsg
+	}
+	return utils.ErrMsg{Err: err}
+}
 
-	base, err := url.Parse(baseURL)
-	if err != nil {
-		return utils.ErrMsg{Err: fmt.Errorf("could not parse baseURL")}
+func (p CivicProvider) client() *http.Client {
+	if p.Client != nil {
+		return p.Client
 	}
+	return &http.Client{Timeout: 10 * time.Second}
+}
 
+func (p CivicProvider) VoterInfo(ctx context.Context, addr address.InputAddress) (VoterInfoResponse, error) {
 	// Query params
 	params := url.Values{}
 	params.Add("address", addr.String())
+
+	var data VoterInfoResponse
+	status, err := p.get(ctx, baseURL, params, &data)
+	if err != nil {
+		return VoterInfoResponse{}, err
+	}
+
+	// Check if the election day is present
+	electionDay := data.Election.ElectionDay
+	if electionDay == "" {
+		return VoterInfoResponse{}, utils.ErrMsg{Err: ErrNoElectionDay, HTTPStatusCode: status}
+	}
+
+	return data, nil
+}
+
+// Elections lists the elections the API currently has data for.
+func (p CivicProvider) Elections(ctx context.Context) ([]Election, error) {
+	var data ElectionsResponse
+	if _, err := p.get(ctx, electionsURL, url.Values{}, &data); err != nil {
+		return nil, err
+	}
+	return data.Elections, nil
+}
+
+// get performs a GET against endpoint and decodes the JSON body into v,
+// returning the response's status code. Errors are utils.ErrMsg values.
+func (p CivicProvider) get(ctx context.Context, endpoint string, params url.Values, v any) (int, error) {
+	c := p.client()
+
+	apiKey, err := secrets.GetAPIKey()
+	if err != nil {
+		return 0, utils.ErrMsg{Err: err}
+	}
+
+	base, err := url.Parse(endpoint)
+	if err != nil {
+		return 0, utils.ErrMsg{Err: fmt.Errorf("could not parse endpoint URL")}
+	}
 	base.RawQuery = params.Encode()
 
 	// Perform the HTTP GET request. The API key goes in a header, never the
 	// URL: a *url.Error stringifies with the full request URL, so a key in the
 	// query string would leak into logs and user-visible error messages.
-	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, base.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, base.String(), nil)
 	if err != nil {
-		return utils.ErrMsg{Err: err}
+		return 0, utils.ErrMsg{Err: err}
 	}
 	req.Header.Set("X-Goog-Api-Key", apiKey)
 	res, err := c.Do(req)
@@ -55,7 +153,7 @@ func CheckServer(addr address.InputAddress) tea.Msg {
 		}
 		log.Error("Could not perform HTTP GET request", "error", loggedErr)
 		// Return a generic message: SSH users see this verbatim.
-		return utils.ErrMsg{Err: errors.New("could not reach the election information service")}
+		return 0, utils.ErrMsg{Err: errors.New("could not reach the election information service")}
 	}
 	defer func() {
 		if err := res.Body.Close(); err != nil {
@@ -65,30 +163,22 @@ func CheckServer(addr address.InputAddress) tea.Msg {
 
 	// Check for non-200 response codes
 	if res.StatusCode != http.StatusOK {
-		return utils.ErrMsg{
+		return res.StatusCode, utils.ErrMsg{
 			Err:            fmt.Errorf("received non-200 response: %s", res.Status),
 			HTTPStatusCode: res.StatusCode,
+			Reason:         errorReason(res.Body),
 		}
 	}
 
 	// Read and parse the JSON response
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
-		return utils.ErrMsg{Err: err, HTTPStatusCode: res.StatusCode}
+		return res.StatusCode, utils.ErrMsg{Err: err, HTTPStatusCode: res.StatusCode}
 	}
 
 	// Parse the JSON response into the defined struct
-	var data VoterInfoResponse
-	err = json.Unmarshal(body, &data)
-	if err != nil {
-		return utils.ErrMsg{Err: err, HTTPStatusCode: res.StatusCode}
+	if err := json.Unmarshal(body, v); err != nil {
+		return res.StatusCode, utils.ErrMsg{Err: err, HTTPStatusCode: res.StatusCode}
 	}
-
-	// Check if the election day is present
-	electionDay := data.Election.ElectionDay
-	if electionDay == "" {
-		return utils.ErrMsg{Err: fmt.Errorf("could not extract election day from response"), HTTPStatusCode: res.StatusCode}
-	}
-
-	return data
+	return res.StatusCode, nil
 }
@@ -75,3 +75,26 @@ func TestCheckServerDoesNotLogAddressOnTransportError(t *testing.T) {
 		t.Fatalf("request URL leaked in server logs: %q", logged)
 	}
 }
+
+func TestErrorReason(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "google error envelope",
+			body: `{"error":{"code":400,"message":"Failed to parse address","errors":[{"message":"Failed to parse address","domain":"global","reason":"parseError"}]}}`,
+			want: "parseError",
+		},
+		{name: "no errors array", body: `{"error":{"code":500,"message":"Backend Error"}}`, want: ""},
+		{name: "not json", body: "<html>Bad Gateway</html>", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errorReason(strings.NewReader(tt.body)); got != tt.want {
+				t.Errorf("errorReason() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
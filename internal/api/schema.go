@@ -1,12 +1,7 @@
 package api
 
-import (
-	"fmt"
-	"net/url"
-	"strings"
-
-	"github.com/govote-sh/govote/internal/utils"
-)
+// The types below mirror the Civic API's JSON, quirks included. The rest of
+// the program works with the domain package's types instead.
 
 type VoterInfoResponse struct {
 	Kind             string         `json:"kind"`
@@ -21,6 +16,12 @@ type VoterInfoResponse struct {
 	MailOnly         bool           `json:"mailOnly"`
 }
 
+// ElectionsResponse is the body of the elections endpoint
+type ElectionsResponse struct {
+	Kind      string     `json:"kind"`
+	Elections []Election `json:"elections"`
+}
+
 // Election Resource
 type Election struct {
 	ID            string `json:"id"`
@@ -40,28 +41,6 @@ type Address struct {
 	Zip          string `json:"zip"`
 }
 
-func (a Address) String() string {
-	parts := make([]string, 0, 6)
-
-	for _, component := range []string{a.LocationName, a.Line1, a.Line2, a.Line3, a.City} {
-		if component != "" {
-			parts = append(parts, component)
-		}
-	}
-
-	// State and zip are one component: "VA 23220", not "VA, 23220".
-	switch {
-	case a.State != "" && a.Zip != "":
-		parts = append(parts, a.State+" "+a.Zip)
-	case a.State != "":
-		parts = append(parts, a.State)
-	case a.Zip != "":
-		parts = append(parts, a.Zip)
-	}
-
-	return strings.Join(parts, ", ")
-}
-
 // PollingPlace Resource (used for pollingLocations, earlyVoteSites, and dropOffLocations)
 type PollingPlace struct {
 	Address       Address  `json:"address"`
@@ -76,40 +55,6 @@ type PollingPlace struct {
 	Sources       []Source `json:"sources"`
 }
 
-func (p PollingPlace) FilterValue() string {
-	if p.Name != "" {
-		return p.Name
-	} else if p.Address.LocationName != "" {
-		return p.Address.LocationName
-	} else {
-		return p.Address.String()
-	}
-}
-
-func (p PollingPlace) Title() string {
-	if p.Name != "" {
-		return p.Name
-	} else if p.Address.LocationName != "" {
-		return p.Address.LocationName
-	} else {
-		return p.Address.String()
-	}
-}
-
-func (p PollingPlace) Description() string {
-	return p.Address.String()
-}
-
-func (p PollingPlace) GetMapsUrl() (string, error) {
-	if address := p.Address.String(); address != "" {
-		return "https://www.google.com/maps/search/?api=1&query=" + url.QueryEscape(address), nil
-	}
-	if p.Latitude == 0 || p.Longitude == 0 {
-		return "", fmt.Errorf("latitude or longitude is missing and address is empty")
-	}
-	return "https://www.google.com/maps/search/?api=1&query=" + url.QueryEscape(fmt.Sprintf("%f,%f", p.Latitude, p.Longitude)), nil
-}
-
 // Contest Resource
 type Contest struct {
 	Type                       string      `json:"type"`
@@ -138,19 +83,6 @@ type Contest struct {
 	Sources                    []Source    `json:"sources"`
 }
 
-func (c Contest) FilterValue() string {
-	return c.BallotTitle
-}
-
-// TODO: I wish I could truncate based on the terminal width, but I think that would require a global variable
-func (c Contest) Title() string {
-	return utils.EllipticalTruncate(c.BallotTitle, 80)
-}
-
-func (c Contest) Description() string {
-	return c.Office
-}
-
 // Candidate Resource
 type Candidate struct {
 	Name          string    `json:"name"`
@@ -163,18 +95,6 @@ type Candidate struct {
 	Channels      []Channel `json:"channels"`
 }
 
-func (c Candidate) FilterValue() string {
-	return c.Name
-}
-
-func (c Candidate) Title() string {
-	return c.Name
-}
-
-func (c Candidate) Description() string {
-	return c.Party
-}
-
 // Channel Resource
 type Channel struct {
 	Type string `json:"type"`
@@ -0,0 +1,478 @@
+// Package batch looks up election information for a CSV of addresses, for
+// outreach teams that need polling places for a whole voter list at once.
+package batch
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"charm.land/log/v2"
+	"github.com/govote-sh/govote/internal/address"
+	"github.com/govote-sh/govote/internal/api"
+	"github.com/govote-sh/govote/internal/domain"
+	"github.com/govote-sh/govote/internal/utils"
+)
+
+// Format is an output file format.
+type Format string
+
+const (
+	CSV   Format = "csv"
+	JSONL Format = "jsonl"
+)
+
+// Options configures a Run.
+type Options struct {
+	Provider api.Provider
+	Format   Format
+
+	// Workers is how many lookups run at once
+	Workers int
+	// Interval is the minimum time between the start of two lookups,
+	// across all workers
+	Interval time.Duration
+	// Attempts is how many times a lookup is tried before a server or
+	// network error is recorded as the row's result
+	Attempts int
+}
+
+// Summary counts what a Run did.
+type Summary struct {
+	Skipped   int // Rows already in the checkpoint
+	Succeeded int
+	Failed    int
+}
+
+// Site is a polling location, early vote site or drop-off location.
+type Site struct {
+	Name      string `json:"name,omitempty"`
+	Address   string `json:"address"`
+	Hours     string `json:"hours,omitempty"`
+	StartDate string `json:"startDate,omitempty"`
+	EndDate   string `json:"endDate,omitempty"`
+}
+
+// Result is one output row.
+type Result struct {
+	Row              int    `json:"row"`
+	Street           string `json:"street"`
+	City             string `json:"city"`
+	State            string `json:"state"`
+	Zip              string `json:"zip"`
+	ElectionName     string `json:"electionName,omitempty"`
+	ElectionDay      string `json:"electionDay,omitempty"`
+	PollingLocations []Site `json:"pollingLocations,omitempty"`
+	EarlyVoteSites   []Site `json:"earlyVoteSites,omitempty"`
+	DropOffLocations []Site `json:"dropOffLocations,omitempty"`
+	Error            string `json:"error,omitempty"`
+}
+
+// csvHeader is the header row of CSV output.
+var csvHeader = []string{
+	"row", "street", "city", "state", "zip",
+	"election_name", "election_day",
+	"polling_location", "polling_hours",
+	"early_vote_sites", "drop_off_locations",
+	"error",
+}
+
+// inputColumns maps each address field to the header names accepted for it.
+var inputColumns = map[string][]string{
+	"street": {"street", "address", "street_address", "line1"},
+	"city":   {"city"},
+	"state":  {"state"},
+	"zip":    {"zip", "zipcode", "zip_code", "postal_code", "postalcode"},
+}
+
+type row struct {
+	num  int
+	addr address.InputAddress
+	key  string // What the checkpoint records the row by
+}
+
+// Run reads addresses from in and writes a Result for each to out. Rows
+// listed in the checkpoint file are skipped, and each row is added to it
+// once its result is written, so an interrupted run picks up where it left
+// off. The checkpoint knows rows by their address rather than their place
+// in the file, so rows added, deleted or re-sorted between runs still line
+// up. An empty checkpoint path disables checkpointing.
+func Run(ctx context.Context, in io.Reader, out io.Writer, checkpointPath string, opts Options) (Summary, error) {
+	var summary Summary
+	opts = withDefaults(opts)
+
+	done, err := readCheckpoint(checkpointPath)
+	if err != nil {
+		return summary, err
+	}
+	rows, err := readRows(in)
+	if err != nil {
+		return summary, err
+	}
+
+	var checkpoint io.Writer = io.Discard
+	if checkpointPath != "" {
+		f, err := os.OpenFile(checkpointPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+		if err != nil {
+			return summary, fmt.Errorf("opening checkpoint: %w", err)
+		}
+		defer func() { _ = f.Close() }()
+		checkpoint = f
+	}
+
+	var todo []row
+	keys := make(map[int]string, len(rows))
+	for _, r := range rows {
+		keys[r.num] = r.key
+		if done[r.key] {
+			summary.Skipped++
+		} else {
+			todo = append(todo, r)
+		}
+	}
+
+	pending := make(chan row)
+	results := make(chan Result)
+
+	// Feed the rows that still need doing
+	go func() {
+		defer close(pending)
+		for _, r := range todo {
+			select {
+			case pending <- r:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	// Look them up, no faster than one start per Interval
+	limiter := time.NewTicker(opts.Interval)
+	defer limiter.Stop()
+	var wg sync.WaitGroup
+	for range opts.Workers {
+		wg.Go(func() {
+			for r := range pending {
+				select {
+				case <-limiter.C:
+				case <-ctx.Done():
+					return
+				}
+				results <- lookup(ctx, opts, r)
+			}
+		})
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	w := newWriter(out, opts.Format)
+	var writeErr error
+	for result := range results {
+		if writeErr != nil {
+			continue // drain so the workers can finish
+		}
+		if ctx.Err() != nil && result.Error != "" {
+			continue // cancelled mid-lookup; leave the row for the next run
+		}
+		if writeErr = w.write(result); writeErr != nil {
+			continue
+		}
+		if _, writeErr = fmt.Fprintln(checkpoint, keys[result.Row]); writeErr != nil {
+			writeErr = fmt.Errorf("writing checkpoint: %w", writeErr)
+			continue
+		}
+		if result.Error == "" {
+			summary.Succeeded++
+		} else {
+			summary.Failed++
+		}
+	}
+	if writeErr != nil {
+		return summary, writeErr
+	}
+	return summary, ctx.Err()
+}
+
+func withDefaults(opts Options) Options {
+	if opts.Provider == nil {
+		opts.Provider = api.CivicProvider{}
+	}
+	if opts.Format == "" {
+		opts.Format = CSV
+	}
+	if opts.Workers <= 0 {
+		opts.Workers = 1
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = time.Millisecond
+	}
+	if opts.Attempts <= 0 {
+		opts.Attempts = 1
+	}
+	return opts
+}
+
+// lookup queries one row, retrying errors that say nothing about the
+// address itself.
+func lookup(ctx context.Context, opts Options, r row) Result {
+	result := Result{Row: r.num, Street: r.addr.Street, City: r.addr.City, State: r.addr.State, Zip: r.addr.PostalCode}
+	if r.addr.IsEmpty() {
+		result.Error = "empty address"
+		return result
+	}
+
+	var raw api.VoterInfoResponse
+	var err error
+	for attempt := 1; attempt <= opts.Attempts; attempt++ {
+		raw, err = opts.Provider.VoterInfo(ctx, r.addr)
+		if err == nil || !retryable(err) || attempt == opts.Attempts {
+			break
+		}
+		select {
+		case <-time.After(time.Duration(attempt) * time.Second):
+		case <-ctx.Done():
+			result.Error = ctx.Err().Error()
+			return result
+		}
+	}
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	data, warnings := domain.FromVoterInfo(raw)
+	for _, w := range warnings {
+		log.Warn("Ignoring unexpected value in API response", "row", r.num, "warning", w)
+	}
+	result.ElectionName = data.Election.Name
+	result.ElectionDay = formatDate(data.Election.Day)
+	result.PollingLocations = sites(data.PollingLocations)
+	result.EarlyVoteSites = sites(data.EarlyVoteSites)
+	result.DropOffLocations = sites(data.DropOffLocations)
+	return result
+}
+
+// retryable reports whether err is a server or network failure, as opposed
+// to the API rejecting the address.
+func retryable(err error) bool {
+	var errMsg utils.ErrMsg
+	if !errors.As(err, &errMsg) {
+		return true
+	}
+	return errMsg.HTTPStatusCode == 0 || errMsg.HTTPStatusCode == 429 || errMsg.HTTPStatusCode >= 500
+}
+
+func sites(places []domain.PollingPlace) []Site {
+	var out []Site
+	for _, p := range places {
+		out = append(out, Site{
+			Name:      p.Name,
+			Address:   p.Address.String(),
+			Hours:     p.Hours,
+			StartDate: formatDate(p.StartDate),
+			EndDate:   formatDate(p.EndDate),
+		})
+	}
+	return out
+}
+
+// formatDate writes dates the way the API sends them, leaving unknown ones
+// blank.
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.DateOnly)
+}
+
+// readRows parses the input CSV. Rows are numbered from 1, not counting the
+// header.
+func readRows(in io.Reader) ([]row, error) {
+	r := csv.NewReader(in)
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading CSV header: %w", err)
+	}
+	cols, err := columnIndexes(header)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []row
+	seen := make(map[string]int)
+	for num := 1; ; num++ {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("reading CSV row %d: %w", num, err)
+		}
+		field := func(name string) string {
+			if i, ok := cols[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		addr := address.InputAddress{
+			Street:     field("street"),
+			City:       field("city"),
+			State:      field("state"),
+			PostalCode: field("zip"),
+		}
+		normalized := strings.ToLower(strings.Join(strings.Fields(addr.String()), " "))
+		seen[normalized]++
+		rows = append(rows, row{num: num, addr: addr, key: checkpointKey(normalized, seen[normalized])})
+	}
+}
+
+// checkpointKey is how the checkpoint records the nth row with a normalized
+// address, so a list that repeats an address has each copy done once. It's
+// a hash, which keeps the addresses themselves out of the checkpoint.
+func checkpointKey(normalized string, n int) string {
+	sum := sha256.Sum256(fmt.Appendf(nil, "%s\x00%d", normalized, n))
+	return hex.EncodeToString(sum[:16])
+}
+
+// columnIndexes finds the address columns in header. At least one must be
+// present.
+func columnIndexes(header []string) (map[string]int, error) {
+	cols := make(map[string]int)
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		for field, names := range inputColumns {
+			for _, accepted := range names {
+				if _, seen := cols[field]; !seen && name == accepted {
+					cols[field] = i
+				}
+			}
+		}
+	}
+	if len(cols) == 0 {
+		return nil, errors.New("CSV header has none of the columns street, city, state, zip")
+	}
+	return cols, nil
+}
+
+// readCheckpoint returns the keys of the rows already completed.
+func readCheckpoint(path string) (map[string]bool, error) {
+	done := make(map[string]bool)
+	if path == "" {
+		return done, nil
+	}
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return done, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("opening checkpoint: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// A torn last line from a crash matches no row, so it's just a row
+		// to redo
+		if key := strings.TrimSpace(scanner.Text()); key != "" {
+			done[key] = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading checkpoint: %w", err)
+	}
+	return done, nil
+}
+
+// writer writes Results in one format, flushing after every row so the
+// output never lags the checkpoint.
+type writer struct {
+	format Format
+	csv    *csv.Writer
+	json   *json.Encoder
+}
+
+func newWriter(out io.Writer, format Format) *writer {
+	if format == JSONL {
+		return &writer{format: format, json: json.NewEncoder(out)}
+	}
+	return &writer{format: format, csv: csv.NewWriter(out)}
+}
+
+// WriteCSVHeader writes the header row for CSV output. Callers appending to
+// an existing file skip it.
+func WriteCSVHeader(out io.Writer) error {
+	w := csv.NewWriter(out)
+	if err := w.Write(csvHeader); err != nil {
+		return fmt.Errorf("writing CSV header: %w", err)
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return fmt.Errorf("writing CSV header: %w", err)
+	}
+	return nil
+}
+
+func (w *writer) write(r Result) error {
+	if w.format == JSONL {
+		if err := w.json.Encode(r); err != nil {
+			return fmt.Errorf("writing result: %w", err)
+		}
+		return nil
+	}
+
+	var pollingLocation, pollingHours string
+	if len(r.PollingLocations) > 0 {
+		pollingLocation = siteString(r.PollingLocations[0])
+		pollingHours = r.PollingLocations[0].Hours
+	}
+	if err := w.csv.Write([]string{
+		strconv.Itoa(r.Row), r.Street, r.City, r.State, r.Zip,
+		r.ElectionName, r.ElectionDay,
+		pollingLocation, pollingHours,
+		joinSites(r.EarlyVoteSites), joinSites(r.DropOffLocations),
+		r.Error,
+	}); err != nil {
+		return fmt.Errorf("writing result: %w", err)
+	}
+	w.csv.Flush()
+	if err := w.csv.Error(); err != nil {
+		return fmt.Errorf("writing result: %w", err)
+	}
+	return nil
+}
+
+func siteString(s Site) string {
+	if s.Name != "" && !strings.HasPrefix(s.Address, s.Name) {
+		return s.Name + ", " + s.Address
+	}
+	return s.Address
+}
+
+func joinSites(sites []Site) string {
+	parts := make([]string, 0, len(sites))
+	for _, s := range sites {
+		part := siteString(s)
+		switch {
+		case s.StartDate != "" && s.EndDate != "" && s.StartDate != s.EndDate:
+			part += fmt.Sprintf(" (%s to %s)", s.StartDate, s.EndDate)
+		case s.StartDate != "":
+			part += fmt.Sprintf(" (%s)", s.StartDate)
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, "; ")
+}
@@ -0,0 +1,152 @@
+package batch
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/govote-sh/govote/internal/address"
+	"github.com/govote-sh/govote/internal/api"
+	"github.com/govote-sh/govote/internal/utils"
+)
+
+// fakeProvider answers from a table keyed by city, failing for unknown
+// cities the way the Civic API rejects an unparseable address.
+type fakeProvider struct {
+	mu      sync.Mutex
+	lookups []string
+}
+
+func (p *fakeProvider) VoterInfo(_ context.Context, addr address.InputAddress) (api.VoterInfoResponse, error) {
+	p.mu.Lock()
+	p.lookups = append(p.lookups, addr.City)
+	p.mu.Unlock()
+
+	if addr.City != "Richmond" {
+		return api.VoterInfoResponse{}, utils.ErrMsg{Err: errors.New("received non-200 response: 400 Bad Request"), HTTPStatusCode: 400}
+	}
+	return api.VoterInfoResponse{
+		Election: api.Election{Name: "Test General Election", ElectionDay: "2026-11-03"},
+		PollingLocations: []api.PollingPlace{{
+			Address:      api.Address{LocationName: "Main St Community Center", Line1: "100 Main St", City: "Richmond", State: "VA"},
+			PollingHours: "6:00 AM - 7:00 PM",
+		}},
+		DropOffLocations: []api.PollingPlace{{
+			Address:   api.Address{LocationName: "City Hall", City: "Richmond", State: "VA"},
+			StartDate: "2026-10-01",
+			EndDate:   "2026-11-03",
+		}},
+	}, nil
+}
+
+const input = `Street,City,State,Zip
+1234 W Broad St,Richmond,VA,23220
+1 Nowhere Ln,Atlantis,VA,
+,,,
+`
+
+func TestRunWritesCSVWithPerRowErrors(t *testing.T) {
+	var out bytes.Buffer
+	if err := WriteCSVHeader(&out); err != nil {
+		t.Fatalf("WriteCSVHeader: %v", err)
+	}
+	summary, err := Run(context.Background(), strings.NewReader(input), &out, "", Options{Provider: &fakeProvider{}, Workers: 2})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if summary.Succeeded != 1 || summary.Failed != 2 {
+		t.Errorf("summary = %+v, want 1 succeeded and 2 failed", summary)
+	}
+
+	records, err := csv.NewReader(&out).ReadAll()
+	if err != nil {
+		t.Fatalf("reading output: %v", err)
+	}
+	byRow := make(map[string]map[string]string)
+	for _, record := range records[1:] {
+		fields := make(map[string]string)
+		for i, name := range records[0] {
+			fields[name] = record[i]
+		}
+		byRow[fields["row"]] = fields
+	}
+
+	ok := byRow["1"]
+	if ok["election_name"] != "Test General Election" || ok["polling_location"] != "Main St Community Center, 100 Main St, Richmond, VA" || ok["polling_hours"] != "6:00 AM - 7:00 PM" {
+		t.Errorf("row 1 = %v", ok)
+	}
+	if ok["drop_off_locations"] != "City Hall, Richmond, VA (2026-10-01 to 2026-11-03)" {
+		t.Errorf("row 1 drop_off_locations = %q", ok["drop_off_locations"])
+	}
+	if !strings.Contains(byRow["2"]["error"], "400") {
+		t.Errorf("row 2 error = %q, want the API error", byRow["2"]["error"])
+	}
+	if byRow["3"]["error"] != "empty address" {
+		t.Errorf("row 3 error = %q, want %q", byRow["3"]["error"], "empty address")
+	}
+}
+
+func TestRunResumesFromCheckpoint(t *testing.T) {
+	checkpoint := filepath.Join(t.TempDir(), "out.checkpoint")
+	var out bytes.Buffer
+
+	first := &fakeProvider{}
+	if _, err := Run(context.Background(), strings.NewReader("city\nRichmond\n"), &out, checkpoint, Options{Provider: first, Format: JSONL}); err != nil {
+		t.Fatalf("first Run: %v", err)
+	}
+
+	// The list grew since the first run; only the new row is looked up
+	second := &fakeProvider{}
+	summary, err := Run(context.Background(), strings.NewReader("city\nRichmond\nRichmond\n"), &out, checkpoint, Options{Provider: second, Format: JSONL})
+	if err != nil {
+		t.Fatalf("second Run: %v", err)
+	}
+	if summary.Skipped != 1 || len(second.lookups) != 1 {
+		t.Errorf("second run skipped %d rows and made %d lookups, want 1 and 1", summary.Skipped, len(second.lookups))
+	}
+
+	var rows []int
+	dec := json.NewDecoder(&out)
+	for dec.More() {
+		var r Result
+		if err := dec.Decode(&r); err != nil {
+			t.Fatalf("decoding output: %v", err)
+		}
+		rows = append(rows, r.Row)
+	}
+	if len(rows) != 2 || rows[0] != 1 || rows[1] != 2 {
+		t.Errorf("output rows = %v, want [1 2]", rows)
+	}
+}
+
+func TestRunResumesAgainstReorderedInput(t *testing.T) {
+	checkpoint := filepath.Join(t.TempDir(), "out.checkpoint")
+	var out bytes.Buffer
+	if _, err := Run(context.Background(), strings.NewReader("city\nRichmond\nAtlantis\n"), &out, checkpoint, Options{Provider: &fakeProvider{}, Format: JSONL}); err != nil {
+		t.Fatalf("first Run: %v", err)
+	}
+
+	// The list was re-sorted and a row inserted at the top; only that row
+	// is new
+	second := &fakeProvider{}
+	summary, err := Run(context.Background(), strings.NewReader("city\nNorfolk\nAtlantis\nRichmond\n"), &out, checkpoint, Options{Provider: second, Format: JSONL})
+	if err != nil {
+		t.Fatalf("second Run: %v", err)
+	}
+	if summary.Skipped != 2 || len(second.lookups) != 1 || second.lookups[0] != "Norfolk" {
+		t.Errorf("second run skipped %d rows and looked up %v, want 2 and [Norfolk]", summary.Skipped, second.lookups)
+	}
+}
+
+func TestRunRejectsCSVWithoutAddressColumns(t *testing.T) {
+	_, err := Run(context.Background(), strings.NewReader("name,phone\nAlex,555\n"), &bytes.Buffer{}, "", Options{Provider: &fakeProvider{}})
+	if err == nil {
+		t.Error("Run error = nil, want an error about the missing columns")
+	}
+}
@@ -0,0 +1,176 @@
+package domain
+
+import "strings"
+
+// ContestType is what a contest decides.
+type ContestType int
+
+const (
+	ContestOther ContestType = iota // Missing or not a type the API documents
+	ContestGeneral
+	ContestPrimary
+	ContestRunoff
+	ContestReferendum
+)
+
+var contestTypeNames = map[ContestType]string{
+	ContestOther:      "Other",
+	ContestGeneral:    "General",
+	ContestPrimary:    "Primary",
+	ContestRunoff:     "Run-off",
+	ContestReferendum: "Referendum",
+}
+
+func (t ContestType) String() string {
+	return contestTypeNames[t]
+}
+
+// parseContestType matches the API's type names, ignoring case and
+// punctuation ("Run-off", "runoff").
+func parseContestType(s string) (ContestType, bool) {
+	s = normalizeEnum(s)
+	for t, name := range contestTypeNames {
+		if t != ContestOther && normalizeEnum(name) == s {
+			return t, true
+		}
+	}
+	return ContestOther, false
+}
+
+// Level is the level of government a contest or office belongs to. Levels
+// are ordered from the widest to the narrowest.
+type Level int
+
+const (
+	LevelUnknown Level = iota
+	LevelInternational
+	LevelCountry
+	LevelAdministrativeArea1
+	LevelRegional
+	LevelAdministrativeArea2
+	LevelLocality
+	LevelSubLocality1
+	LevelSubLocality2
+	LevelSpecial
+)
+
+// levelAPINames are the API's names for each level.
+var levelAPINames = map[Level]string{
+	LevelInternational:       "international",
+	LevelCountry:             "country",
+	LevelAdministrativeArea1: "administrativeArea1",
+	LevelRegional:            "regional",
+	LevelAdministrativeArea2: "administrativeArea2",
+	LevelLocality:            "locality",
+	LevelSubLocality1:        "subLocality1",
+	LevelSubLocality2:        "subLocality2",
+	LevelSpecial:             "special",
+}
+
+var levelNames = map[Level]string{
+	LevelUnknown:             "Other",
+	LevelInternational:       "International",
+	LevelCountry:             "Federal",
+	LevelAdministrativeArea1: "State",
+	LevelRegional:            "Regional",
+	LevelAdministrativeArea2: "County",
+	LevelLocality:            "City",
+	LevelSubLocality1:        "Sub-locality",
+	LevelSubLocality2:        "Sub-locality",
+	LevelSpecial:             "Special District",
+}
+
+// String names the level the way a US voter would, e.g. "County" for
+// administrativeArea2.
+func (l Level) String() string {
+	return levelNames[l]
+}
+
+// APIName is the level's name in the Civic API, e.g. "administrativeArea2".
+func (l Level) APIName() string {
+	return levelAPINames[l]
+}
+
+func parseLevel(s string) (Level, bool) {
+	for l, name := range levelAPINames {
+		if strings.EqualFold(name, s) {
+			return l, true
+		}
+	}
+	return LevelUnknown, false
+}
+
+func normalizeEnum(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == '_' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToLower(strings.TrimSpace(s)))
+}
+
+type Contest struct {
+	Type                     ContestType
+	BallotTitle              string
+	Office                   string
+	Levels                   []Level
+	Roles                    []string
+	District                 District
+	PrimaryParty             string
+	Special                  bool
+	ElectorateSpecifications string
+	NumberElected            int // Zero if unknown
+	NumberVotingFor          int // Zero if unknown
+	BallotPlacement          int // Zero if unknown
+	Candidates               []Candidate
+	Referendum               *Referendum // Set for ballot measures
+	Sources                  []Source
+}
+
+func (c Contest) FilterValue() string {
+	return c.BallotTitle
+}
+
+func (c Contest) Title() string {
+	return c.BallotTitle
+}
+
+func (c Contest) Description() string {
+	return c.Office
+}
+
+type District struct {
+	ID    string
+	Name  string
+	Scope string
+}
+
+type Candidate struct {
+	Name          string
+	Party         string
+	URL           string
+	Phone         string
+	PhotoURL      string
+	Email         string
+	OrderOnBallot int
+	Channels      []Channel
+}
+
+// Channel is a candidate's social media account.
+type Channel struct {
+	Type string
+	ID   string
+}
+
+type Referendum struct {
+	Title            string
+	Subtitle         string
+	URL              string
+	Brief            string
+	Text             string
+	ProStatement     string
+	ConStatement     string
+	PassageThreshold string
+	EffectOfAbstain  string
+	BallotResponses  []string
+}
@@ -0,0 +1,152 @@
+// Package domain is govote's own model of voter information. The api package
+// mirrors the Civic API's JSON, quirks included; the types here are what the
+// rest of the program works with: counts are integers, dates are time.Time,
+// states are a plain list, and contest types and levels are enums. Convert
+// with FromVoterInfo and FromElections.
+package domain
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type VoterInfo struct {
+	Election         Election
+	OtherElections   []Election
+	NormalizedInput  Address // Zero if the API did not echo the address back
+	PollingLocations []PollingPlace
+	EarlyVoteSites   []PollingPlace
+	DropOffLocations []PollingPlace
+	Contests         []Contest
+	States           []State // Usually exactly one
+	MailOnly         bool
+}
+
+type Election struct {
+	ID         string
+	Name       string
+	Day        time.Time // Midnight UTC on the election day; zero if unknown
+	DivisionID string
+}
+
+type Address struct {
+	LocationName string
+	Line1        string
+	Line2        string
+	Line3        string
+	City         string
+	State        string
+	Zip          string
+}
+
+func (a Address) String() string {
+	parts := make([]string, 0, 6)
+
+	for _, component := range []string{a.LocationName, a.Line1, a.Line2, a.Line3, a.City} {
+		if component != "" {
+			parts = append(parts, component)
+		}
+	}
+
+	// State and zip are one component: "VA 23220", not "VA, 23220".
+	switch {
+	case a.State != "" && a.Zip != "":
+		parts = append(parts, a.State+" "+a.Zip)
+	case a.State != "":
+		parts = append(parts, a.State)
+	case a.Zip != "":
+		parts = append(parts, a.Zip)
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// PollingPlace is a polling location, early vote site or drop-off location.
+type PollingPlace struct {
+	Name          string
+	Address       Address
+	Hours         string // Free text, usually one "Day: hours" line per day
+	Notes         string
+	VoterServices string
+	StartDate     time.Time // Midnight UTC; zero if unknown
+	EndDate       time.Time // Midnight UTC; zero if unknown
+	Latitude      float64
+	Longitude     float64
+	Sources       []Source
+}
+
+func (p PollingPlace) FilterValue() string {
+	return p.Title()
+}
+
+func (p PollingPlace) Title() string {
+	if p.Name != "" {
+		return p.Name
+	} else if p.Address.LocationName != "" {
+		return p.Address.LocationName
+	} else {
+		return p.Address.String()
+	}
+}
+
+func (p PollingPlace) Description() string {
+	return p.Address.String()
+}
+
+func (p PollingPlace) GetMapsUrl() (string, error) {
+	if address := p.Address.String(); address != "" {
+		return "https://www.google.com/maps/search/?api=1&query=" + url.QueryEscape(address), nil
+	}
+	if p.Latitude == 0 || p.Longitude == 0 {
+		return "", fmt.Errorf("latitude or longitude is missing and address is empty")
+	}
+	return "https://www.google.com/maps/search/?api=1&query=" + url.QueryEscape(fmt.Sprintf("%f,%f", p.Latitude, p.Longitude)), nil
+}
+
+type Source struct {
+	Name     string
+	Official bool
+}
+
+type State struct {
+	Name              string
+	Administration    Administration
+	LocalJurisdiction *Jurisdiction // nil if the API sent none
+	Sources           []Source
+}
+
+// Jurisdiction is a local election authority, such as a county board of
+// elections.
+type Jurisdiction struct {
+	Name           string
+	Administration Administration
+}
+
+// Administration is an election administration body.
+type Administration struct {
+	Name                        string
+	ElectionInfoURL             string
+	RegistrationURL             string
+	RegistrationConfirmationURL string
+	NoticeText                  string
+	NoticeURL                   string
+	AbsenteeVotingInfoURL       string
+	VotingLocationFinderURL     string
+	BallotInfoURL               string
+	ElectionRulesURL            string
+	VoterServices               []string
+	HoursOfOperation            string
+	CorrespondenceAddress       Address
+	PhysicalAddress             Address
+	Officials                   []Official
+}
+
+type Official struct {
+	Name  string
+	Title string
+	Phone string
+	Fax   string
+	Email string
+}
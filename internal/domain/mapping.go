@@ -0,0 +1,284 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/govote-sh/govote/internal/api"
+)
+
+// Warning describes a value in an API response that could not be used as
+// sent. The value is dropped, and the rest of the response is still used.
+type Warning struct {
+	Field   string // Path in the API's JSON, e.g. "contests[2].numberElected"
+	Value   string
+	Problem string
+}
+
+func (w Warning) String() string {
+	return fmt.Sprintf("%s: %s (got %q)", w.Field, w.Problem, w.Value)
+}
+
+// mapper converts API responses, collecting warnings as it goes.
+type mapper struct {
+	warnings []Warning
+}
+
+func (m *mapper) warn(field, value, problem string) {
+	m.warnings = append(m.warnings, Warning{Field: field, Value: value, Problem: problem})
+}
+
+// FromVoterInfo converts a voterinfo response, reporting anything it had to
+// drop.
+func FromVoterInfo(r api.VoterInfoResponse) (VoterInfo, []Warning) {
+	var m mapper
+	info := VoterInfo{
+		Election:        m.election("election", r.Election),
+		OtherElections:  make([]Election, 0, len(r.OtherElections)),
+		NormalizedInput: fromAddress(r.NormalizedInput),
+		Contests:        make([]Contest, 0, len(r.Contests)),
+		MailOnly:        r.MailOnly,
+	}
+	for i, e := range r.OtherElections {
+		info.OtherElections = append(info.OtherElections, m.election(fmt.Sprintf("otherElections[%d]", i), e))
+	}
+	info.PollingLocations = m.pollingPlaces("pollingLocations", r.PollingLocations)
+	info.EarlyVoteSites = m.pollingPlaces("earlyVoteSites", r.EarlyVoteSites)
+	info.DropOffLocations = m.pollingPlaces("dropOffLocations", r.DropOffLocations)
+	for i, c := range r.Contests {
+		info.Contests = append(info.Contests, m.contest(fmt.Sprintf("contests[%d]", i), c))
+	}
+	info.States = make([]State, 0, len(r.State))
+	for _, s := range r.State {
+		info.States = append(info.States, fromState(s))
+	}
+	return info, m.warnings
+}
+
+// FromElections converts the elections endpoint's list.
+func FromElections(elections []api.Election) ([]Election, []Warning) {
+	var m mapper
+	out := make([]Election, 0, len(elections))
+	for i, e := range elections {
+		out = append(out, m.election(fmt.Sprintf("elections[%d]", i), e))
+	}
+	return out, m.warnings
+}
+
+func (m *mapper) election(field string, e api.Election) Election {
+	return Election{
+		ID:         e.ID,
+		Name:       e.Name,
+		Day:        m.date(field+".electionDay", e.ElectionDay),
+		DivisionID: e.OcdDivisionId,
+	}
+}
+
+// date parses the API's YYYY-MM-DD dates. A missing date is not a problem;
+// a garbled one is.
+func (m *mapper) date(field, s string) time.Time {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		m.warn(field, s, "not a YYYY-MM-DD date")
+		return time.Time{}
+	}
+	return t
+}
+
+// count parses the API's counts, which are documented as numbers but sent
+// as strings.
+func (m *mapper) count(field, s string) int {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 {
+		m.warn(field, s, "not a count")
+		return 0
+	}
+	return n
+}
+
+func fromAddress(a api.Address) Address {
+	return Address{
+		LocationName: a.LocationName,
+		Line1:        a.Line1,
+		Line2:        a.Line2,
+		Line3:        a.Line3,
+		City:         a.City,
+		State:        a.State,
+		Zip:          a.Zip,
+	}
+}
+
+func (m *mapper) pollingPlaces(field string, places []api.PollingPlace) []PollingPlace {
+	out := make([]PollingPlace, 0, len(places))
+	for i, p := range places {
+		out = append(out, m.pollingPlace(fmt.Sprintf("%s[%d]", field, i), p))
+	}
+	return out
+}
+
+func (m *mapper) pollingPlace(field string, p api.PollingPlace) PollingPlace {
+	out := PollingPlace{
+		Name:          p.Name,
+		Address:       fromAddress(p.Address),
+		Hours:         p.PollingHours,
+		Notes:         p.Notes,
+		VoterServices: p.VoterServices,
+		StartDate:     m.date(field+".startDate", p.StartDate),
+		EndDate:       m.date(field+".endDate", p.EndDate),
+		Sources:       fromSources(p.Sources),
+	}
+	if !out.StartDate.IsZero() && out.EndDate.Before(out.StartDate) {
+		m.warn(field+".endDate", p.EndDate, "before the start date")
+		out.EndDate = time.Time{}
+	}
+	if p.Latitude < -90 || p.Latitude > 90 || p.Longitude < -180 || p.Longitude > 180 {
+		m.warn(field, fmt.Sprintf("%f,%f", p.Latitude, p.Longitude), "coordinates out of range")
+	} else {
+		out.Latitude, out.Longitude = p.Latitude, p.Longitude
+	}
+	return out
+}
+
+func (m *mapper) contest(field string, c api.Contest) Contest {
+	out := Contest{
+		BallotTitle:              c.BallotTitle,
+		Office:                   c.Office,
+		Roles:                    c.Roles,
+		District:                 District{ID: c.District.ID, Name: c.District.Name, Scope: c.District.Scope},
+		PrimaryParty:             c.PrimaryParty,
+		ElectorateSpecifications: c.ElectorateSpecifications,
+		NumberElected:            m.count(field+".numberElected", c.NumberElected),
+		NumberVotingFor:          m.count(field+".numberVotingFor", c.NumberVotingFor),
+		BallotPlacement:          m.count(field+".ballotPlacement", c.BallotPlacement),
+		Candidates:               make([]Candidate, 0, len(c.Candidates)),
+		Sources:                  fromSources(c.Sources),
+	}
+
+	if c.Type != "" {
+		t, ok := parseContestType(c.Type)
+		if !ok {
+			m.warn(field+".type", c.Type, "unknown contest type")
+		}
+		out.Type = t
+	}
+
+	for i, l := range c.Level {
+		level, ok := parseLevel(l)
+		if !ok {
+			m.warn(fmt.Sprintf("%s.level[%d]", field, i), l, "unknown level")
+			continue
+		}
+		out.Levels = append(out.Levels, level)
+	}
+
+	switch strings.ToLower(strings.TrimSpace(c.Special)) {
+	case "yes", "true":
+		out.Special = true
+	case "", "no", "false":
+	default:
+		m.warn(field+".special", c.Special, "not yes or no")
+	}
+
+	for _, cand := range c.Candidates {
+		out.Candidates = append(out.Candidates, Candidate{
+			Name:          cand.Name,
+			Party:         cand.Party,
+			URL:           cand.CandidateUrl,
+			Phone:         cand.Phone,
+			PhotoURL:      cand.PhotoUrl,
+			Email:         cand.Email,
+			OrderOnBallot: int(cand.OrderOnBallot),
+			Channels:      fromChannels(cand.Channels),
+		})
+	}
+
+	if c.ReferendumTitle != "" || c.ReferendumSubtitle != "" || c.ReferendumBrief != "" || c.ReferendumText != "" {
+		out.Referendum = &Referendum{
+			Title:            c.ReferendumTitle,
+			Subtitle:         c.ReferendumSubtitle,
+			URL:              c.ReferendumUrl,
+			Brief:            c.ReferendumBrief,
+			Text:             c.ReferendumText,
+			ProStatement:     c.ReferendumProStatement,
+			ConStatement:     c.ReferendumConStatement,
+			PassageThreshold: c.ReferendumPassageThreshold,
+			EffectOfAbstain:  c.ReferendumEffectOfAbstain,
+			BallotResponses:  c.ReferendumBallotResponses,
+		}
+		if out.Type == ContestOther {
+			out.Type = ContestReferendum
+		}
+	}
+	return out
+}
+
+func fromChannels(channels []api.Channel) []Channel {
+	out := make([]Channel, 0, len(channels))
+	for _, c := range channels {
+		out = append(out, Channel{Type: c.Type, ID: c.ID})
+	}
+	return out
+}
+
+func fromSources(sources []api.Source) []Source {
+	out := make([]Source, 0, len(sources))
+	for _, s := range sources {
+		out = append(out, Source{Name: s.Name, Official: s.Official})
+	}
+	return out
+}
+
+func fromState(s api.State) State {
+	out := State{
+		Name:           s.Name,
+		Administration: fromAdministration(s.ElectionAdministrationBody),
+		Sources:        fromSources(s.Sources),
+	}
+	if s.LocalJurisdiction != nil {
+		out.LocalJurisdiction = &Jurisdiction{
+			Name:           s.LocalJurisdiction.Name,
+			Administration: fromAdministration(s.LocalJurisdiction.ElectionAdministrationBody),
+		}
+	}
+	return out
+}
+
+func fromAdministration(b api.ElectionAdministrationBody) Administration {
+	out := Administration{
+		Name:                        b.Name,
+		ElectionInfoURL:             b.ElectionInfoUrl,
+		RegistrationURL:             b.ElectionRegistrationUrl,
+		RegistrationConfirmationURL: b.ElectionRegistrationConfirmationUrl,
+		NoticeText:                  b.ElectionNoticeText,
+		NoticeURL:                   b.ElectionNoticeUrl,
+		AbsenteeVotingInfoURL:       b.AbsenteeVotingInfoUrl,
+		VotingLocationFinderURL:     b.VotingLocationFinderUrl,
+		BallotInfoURL:               b.BallotInfoUrl,
+		ElectionRulesURL:            b.ElectionRulesUrl,
+		VoterServices:               b.VoterServices,
+		HoursOfOperation:            b.HoursOfOperation,
+		CorrespondenceAddress:       fromAddress(b.CorrespondenceAddress),
+		PhysicalAddress:             fromAddress(b.PhysicalAddress),
+		Officials:                   make([]Official, 0, len(b.ElectionOfficials)),
+	}
+	for _, o := range b.ElectionOfficials {
+		out.Officials = append(out.Officials, Official{
+			Name:  o.Name,
+			Title: o.Title,
+			Phone: o.OfficePhoneNumber,
+			Fax:   o.FaxNumber,
+			Email: o.EmailAddress,
+		})
+	}
+	return out
+}
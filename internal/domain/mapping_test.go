@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/govote-sh/govote/internal/api"
+)
+
+func TestFromVoterInfo(t *testing.T) {
+	info, warnings := FromVoterInfo(api.VoterInfoResponse{
+		Election:       api.Election{ID: "2000", Name: "General", ElectionDay: "2026-11-03"},
+		OtherElections: []api.Election{{ID: "2001", ElectionDay: "November 3rd"}},
+		EarlyVoteSites: []api.PollingPlace{{
+			Name:      "Library",
+			StartDate: "2026-10-20",
+			EndDate:   "2026-10-10",
+			Latitude:  37.5,
+			Longitude: -77.4,
+		}},
+		Contests: []api.Contest{
+			{Type: "Run-off", Level: []string{"administrativeArea2", "galaxy"}, NumberElected: "2", NumberVotingFor: "one", Special: "Yes"},
+			{ReferendumTitle: "Question 1"},
+		},
+		State: []api.State{{Name: "Virginia"}, {Name: "Elsewhere"}},
+	})
+
+	if want := time.Date(2026, time.November, 3, 0, 0, 0, 0, time.UTC); !info.Election.Day.Equal(want) {
+		t.Errorf("election day = %v, want %v", info.Election.Day, want)
+	}
+	if !info.OtherElections[0].Day.IsZero() {
+		t.Errorf("malformed other election day = %v, want zero", info.OtherElections[0].Day)
+	}
+
+	site := info.EarlyVoteSites[0]
+	if site.StartDate.IsZero() || !site.EndDate.IsZero() {
+		t.Errorf("site dates = %v → %v, want the start kept and the inverted end dropped", site.StartDate, site.EndDate)
+	}
+	if site.Latitude != 37.5 || site.Longitude != -77.4 {
+		t.Errorf("coordinates = %v,%v", site.Latitude, site.Longitude)
+	}
+
+	runoff := info.Contests[0]
+	if runoff.Type != ContestRunoff || !runoff.Special || runoff.NumberElected != 2 || runoff.NumberVotingFor != 0 {
+		t.Errorf("contest = %+v", runoff)
+	}
+	if !reflect.DeepEqual(runoff.Levels, []Level{LevelAdministrativeArea2}) {
+		t.Errorf("levels = %v, want [County]", runoff.Levels)
+	}
+	if measure := info.Contests[1]; measure.Type != ContestReferendum || measure.Referendum == nil {
+		t.Errorf("a contest with referendum fields should be a referendum, got %+v", measure)
+	}
+
+	if len(info.States) != 2 || info.States[1].Name != "Elsewhere" {
+		t.Errorf("states = %+v, want both", info.States)
+	}
+
+	var fields []string
+	for _, w := range warnings {
+		fields = append(fields, w.Field)
+	}
+	want := []string{
+		"otherElections[0].electionDay",
+		"earlyVoteSites[0].endDate",
+		"contests[0].numberVotingFor",
+		"contests[0].level[1]",
+	}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("warnings for %v, want %v", fields, want)
+	}
+}
+
+func TestFromVoterInfoEmpty(t *testing.T) {
+	info, warnings := FromVoterInfo(api.VoterInfoResponse{})
+	if len(warnings) != 0 {
+		t.Errorf("warnings = %v, want none", warnings)
+	}
+	if len(info.States) != 0 || !info.Election.Day.IsZero() {
+		t.Errorf("info = %+v, want zero values", info)
+	}
+}
+
+func TestParseContestType(t *testing.T) {
+	for _, s := range []string{"General", "general", "Run-off", "runoff", "RUN OFF", "Referendum"} {
+		if _, ok := parseContestType(s); !ok {
+			t.Errorf("parseContestType(%q) not recognized", s)
+		}
+	}
+	if got, ok := parseContestType("Recall"); ok || got != ContestOther {
+		t.Errorf("parseContestType(%q) = %v, %v; want Other, false", "Recall", got, ok)
+	}
+}
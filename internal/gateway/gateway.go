@@ -0,0 +1,162 @@
+// Package gateway serves the same election data as the TUI over a small,
+// read-only HTTP JSON API, so partner organizations can embed it without
+// dealing with the Civic API directly.
+package gateway
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"charm.land/log/v2"
+	"github.com/govote-sh/govote/internal/address"
+	"github.com/govote-sh/govote/internal/api"
+	"github.com/govote-sh/govote/internal/domain"
+	"github.com/govote-sh/govote/internal/utils"
+)
+
+// maxAddressLength bounds the address parameter; real addresses are far
+// shorter.
+const maxAddressLength = 300
+
+// Options configures a gateway.
+type Options struct {
+	// Rate is how many requests per second one client IP may make on
+	// average, and Burst how many it may make at once
+	Rate  float64
+	Burst int
+
+	// TrustProxy takes the client IP from the Fly-Client-IP header, which
+	// is only safe behind Fly's proxy
+	TrustProxy bool
+}
+
+type gateway struct {
+	provider  api.Provider
+	elections api.ElectionLister
+	limiter   *ipLimiter
+	opts      Options
+}
+
+// New returns the gateway's HTTP handler, backed by the same provider (and
+// so the same cache) as the TUI.
+func New(provider api.Provider, elections api.ElectionLister, opts Options) http.Handler {
+	g := &gateway{
+		provider:  provider,
+		elections: elections,
+		limiter:   newIPLimiter(opts.Rate, opts.Burst, time.Now),
+		opts:      opts,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/voterinfo", g.voterInfo)
+	mux.HandleFunc("GET /v1/elections", g.listElections)
+	return g.middleware(mux)
+}
+
+// middleware applies per-IP rate limits and the headers every response gets.
+func (g *gateway) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		if !g.limiter.allow(g.clientIP(r)) {
+			w.Header().Set("Retry-After", "1")
+			writeError(w, http.StatusTooManyRequests, "rate limit exceeded")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (g *gateway) clientIP(r *http.Request) string {
+	if g.opts.TrustProxy {
+		if ip := r.Header.Get("Fly-Client-IP"); ip != "" {
+			return ip
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func (g *gateway) voterInfo(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	addr := address.InputAddress{Street: strings.TrimSpace(query.Get("address"))}
+	if addr.IsEmpty() {
+		writeError(w, http.StatusBadRequest, "the address parameter is required")
+		return
+	}
+	if len(addr.Street) > maxAddressLength {
+		writeError(w, http.StatusBadRequest, "the address parameter is too long")
+		return
+	}
+
+	raw, err := g.provider.VoterInfo(r.Context(), addr)
+	if err != nil {
+		writeProviderError(w, err)
+		return
+	}
+	data, warnings := domain.FromVoterInfo(raw)
+	logWarnings(warnings)
+	writeJSON(w, http.StatusOK, fromVoterInfo(data))
+}
+
+func (g *gateway) listElections(w http.ResponseWriter, r *http.Request) {
+	raw, err := g.elections.Elections(r.Context())
+	if err != nil {
+		writeProviderError(w, err)
+		return
+	}
+	elections, warnings := domain.FromElections(raw)
+	logWarnings(warnings)
+	writeJSON(w, http.StatusOK, Elections{Elections: mapSlice(elections, fromElection)})
+}
+
+func logWarnings(warnings []domain.Warning) {
+	for _, w := range warnings {
+		log.Warn("Ignoring unexpected value in API response", "warning", w)
+	}
+}
+
+// errorResponse is the body of every non-200 response.
+type errorResponse struct {
+	Error struct {
+		Status  int    `json:"status"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// writeProviderError maps a lookup failure onto an HTTP status. Upstream
+// messages are not passed through: they can carry request details.
+func writeProviderError(w http.ResponseWriter, err error) {
+	var errMsg utils.ErrMsg
+	errors.As(err, &errMsg)
+	switch {
+	case errors.Is(errMsg.Err, api.ErrNoElectionDay), errMsg.HTTPStatusCode == http.StatusNotFound:
+		writeError(w, http.StatusNotFound, "no election information is available for this address")
+	case errMsg.HTTPStatusCode >= 400 && errMsg.HTTPStatusCode < 500:
+		writeError(w, http.StatusBadRequest, "the address could not be understood")
+	default:
+		log.Error("Gateway lookup failed", "error", err)
+		writeError(w, http.StatusBadGateway, "the election information service is unavailable")
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	var body errorResponse
+	body.Error.Status = status
+	body.Error.Message = message
+	writeJSON(w, status, body)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Error("Failed to write gateway response", "error", err)
+	}
+}
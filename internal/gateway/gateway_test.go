@@ -0,0 +1,185 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/govote-sh/govote/internal/address"
+	"github.com/govote-sh/govote/internal/api"
+	"github.com/govote-sh/govote/internal/utils"
+)
+
+type fakeProvider struct {
+	data api.VoterInfoResponse
+	err  error
+	addr address.InputAddress
+}
+
+func (p *fakeProvider) VoterInfo(_ context.Context, addr address.InputAddress) (api.VoterInfoResponse, error) {
+	p.addr = addr
+	return p.data, p.err
+}
+
+func (p *fakeProvider) Elections(context.Context) ([]api.Election, error) {
+	return []api.Election{{ID: "2000", Name: "VIP Test Election", ElectionDay: "2025-06-06", OcdDivisionId: "ocd-division/country:us"}}, p.err
+}
+
+func get(t *testing.T, h http.Handler, target string) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	return rec
+}
+
+func TestVoterInfo(t *testing.T) {
+	p := &fakeProvider{data: api.VoterInfoResponse{
+		Election: api.Election{ID: "2000", Name: "VIP Test Election", ElectionDay: "2025-06-06"},
+		Contests: []api.Contest{{Office: "Mayor", NumberElected: "1", NumberVotingFor: "one", Special: "yes"}},
+	}}
+	h := New(p, p, Options{Rate: 100, Burst: 100})
+
+	rec := get(t, h, "/v1/voterinfo?address=1263+Pacific+Ave.+Kansas+City+KS")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", rec.Code, rec.Body)
+	}
+	if p.addr.Street != "1263 Pacific Ave. Kansas City KS" {
+		t.Errorf("looked up %q", p.addr.Street)
+	}
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "*" {
+		t.Errorf("Access-Control-Allow-Origin = %q", got)
+	}
+
+	var body VoterInfo
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decoding body: %v", err)
+	}
+	if body.Election.Day != "2025-06-06" {
+		t.Errorf("election day = %q", body.Election.Day)
+	}
+	if body.PollingLocations == nil || body.OtherElections == nil {
+		t.Error("empty lists should encode as [], not null")
+	}
+	contest := body.Contests[0]
+	if contest.NumberElected == nil || *contest.NumberElected != 1 {
+		t.Errorf("numberElected = %v, want 1", contest.NumberElected)
+	}
+	if contest.NumberVotingFor != nil {
+		t.Errorf("numberVotingFor = %v, want omitted", *contest.NumberVotingFor)
+	}
+	if !contest.Special {
+		t.Error("special = false, want true")
+	}
+	if body.States == nil {
+		t.Error("states = null, want []")
+	}
+}
+
+func TestVoterInfoKeepsEveryState(t *testing.T) {
+	p := &fakeProvider{data: api.VoterInfoResponse{
+		State: []api.State{{Name: "Virginia"}, {Name: "Elsewhere"}},
+	}}
+	rec := get(t, New(p, p, Options{Rate: 100, Burst: 100}), "/v1/voterinfo?address=Richmond+VA")
+	var body VoterInfo
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decoding body: %v", err)
+	}
+	if len(body.States) != 2 || body.States[1].Name != "Elsewhere" {
+		t.Errorf("states = %+v, want both", body.States)
+	}
+}
+
+func TestVoterInfoErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		err    error
+		want   int
+	}{
+		{"missing address", "/v1/voterinfo", nil, http.StatusBadRequest},
+		{"no election", "/v1/voterinfo?address=x", utils.ErrMsg{Err: api.ErrNoElectionDay}, http.StatusNotFound},
+		{"unparseable", "/v1/voterinfo?address=x", utils.ErrMsg{HTTPStatusCode: 400, Reason: "parseError"}, http.StatusBadRequest},
+		{"upstream down", "/v1/voterinfo?address=x", utils.ErrMsg{HTTPStatusCode: 503}, http.StatusBadGateway},
+		{"transport", "/v1/voterinfo?address=x", utils.ErrMsg{Err: context.DeadlineExceeded}, http.StatusBadGateway},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &fakeProvider{err: tt.err}
+			rec := get(t, New(p, p, Options{Rate: 100, Burst: 100}), tt.target)
+			if rec.Code != tt.want {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.want)
+			}
+			var body errorResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("decoding body: %v", err)
+			}
+			if body.Error.Status != tt.want || body.Error.Message == "" {
+				t.Errorf("error body = %+v", body.Error)
+			}
+		})
+	}
+}
+
+func TestElections(t *testing.T) {
+	p := &fakeProvider{}
+	rec := get(t, New(p, p, Options{Rate: 100, Burst: 100}), "/v1/elections")
+	var body Elections
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decoding body: %v", err)
+	}
+	want := Election{ID: "2000", Name: "VIP Test Election", Day: "2025-06-06", DivisionID: "ocd-division/country:us"}
+	if len(body.Elections) != 1 || body.Elections[0] != want {
+		t.Errorf("elections = %+v", body.Elections)
+	}
+}
+
+func TestOnlyGet(t *testing.T) {
+	p := &fakeProvider{}
+	rec := httptest.NewRecorder()
+	New(p, p, Options{Rate: 100, Burst: 100}).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/elections", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusMethodNotAllowed)
+	}
+}
+
+func TestIPLimiter(t *testing.T) {
+	now := time.Now()
+	l := newIPLimiter(1, 2, func() time.Time { return now })
+
+	if !l.allow("a") || !l.allow("a") {
+		t.Fatal("burst requests should be allowed")
+	}
+	if l.allow("a") {
+		t.Error("third request should be limited")
+	}
+	if !l.allow("b") {
+		t.Error("other clients have their own bucket")
+	}
+
+	now = now.Add(time.Second)
+	if !l.allow("a") {
+		t.Error("a token should have refilled after a second")
+	}
+
+	now = now.Add(idleBucketAge + time.Second)
+	l.allow("c")
+	if _, ok := l.buckets["a"]; ok {
+		t.Error("idle buckets should be dropped")
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/v1/elections", nil)
+	r.RemoteAddr = "10.0.0.1:5000"
+	r.Header.Set("Fly-Client-IP", "203.0.113.7")
+
+	if got := (&gateway{}).clientIP(r); got != "10.0.0.1" {
+		t.Errorf("untrusted clientIP = %q", got)
+	}
+	if got := (&gateway{opts: Options{TrustProxy: true}}).clientIP(r); got != "203.0.113.7" {
+		t.Errorf("trusted clientIP = %q", got)
+	}
+}
@@ -0,0 +1,66 @@
+package gateway
+
+import (
+	"sync"
+	"time"
+)
+
+// idleBucketAge is how long a client's bucket is kept after its last
+// request. A full bucket is indistinguishable from a missing one, so
+// anything idle long enough to refill is safe to drop.
+const idleBucketAge = 10 * time.Minute
+
+// ipLimiter is a token bucket per client IP.
+type ipLimiter struct {
+	rate  float64
+	burst float64
+	now   func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func newIPLimiter(rate float64, burst int, now func() time.Time) *ipLimiter {
+	if rate <= 0 {
+		rate = 1
+	}
+	if burst <= 0 {
+		burst = 1
+	}
+	return &ipLimiter{rate: rate, burst: float64(burst), now: now, buckets: make(map[string]*bucket), lastSweep: now()}
+}
+
+// allow takes a token from ip's bucket, reporting whether there was one.
+func (l *ipLimiter) allow(ip string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if now.Sub(l.lastSweep) > idleBucketAge {
+		for key, b := range l.buckets {
+			if now.Sub(b.last) > idleBucketAge {
+				delete(l.buckets, key)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	b, ok := l.buckets[ip]
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[ip] = b
+	}
+	b.tokens = min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rate)
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
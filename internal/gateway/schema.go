@@ -0,0 +1,340 @@
+package gateway
+
+import (
+	"time"
+
+	"github.com/govote-sh/govote/internal/domain"
+)
+
+// The types below are the gateway's v1 JSON schema. They are built from the
+// domain package's types, but kept separate so this schema only ever changes
+// compatibly.
+
+// VoterInfo is the body of GET /v1/voterinfo.
+type VoterInfo struct {
+	Election          Election   `json:"election"`
+	OtherElections    []Election `json:"otherElections"`
+	NormalizedAddress *Address   `json:"normalizedAddress,omitempty"`
+	MailOnly          bool       `json:"mailOnly"`
+	PollingLocations  []Location `json:"pollingLocations"`
+	EarlyVoteSites    []Location `json:"earlyVoteSites"`
+	DropOffLocations  []Location `json:"dropOffLocations"`
+	Contests          []Contest  `json:"contests"`
+	States            []State    `json:"states"`
+}
+
+// Elections is the body of GET /v1/elections.
+type Elections struct {
+	Elections []Election `json:"elections"`
+}
+
+type Election struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Day        string `json:"day"` // YYYY-MM-DD
+	DivisionID string `json:"divisionId,omitempty"`
+}
+
+type Address struct {
+	LocationName string `json:"locationName,omitempty"`
+	Line1        string `json:"line1,omitempty"`
+	Line2        string `json:"line2,omitempty"`
+	Line3        string `json:"line3,omitempty"`
+	City         string `json:"city,omitempty"`
+	State        string `json:"state,omitempty"`
+	Zip          string `json:"zip,omitempty"`
+	Formatted    string `json:"formatted"`
+}
+
+// Location is a polling location, early vote site or drop-off location.
+type Location struct {
+	Name          string   `json:"name,omitempty"`
+	Address       Address  `json:"address"`
+	Hours         string   `json:"hours,omitempty"`
+	Notes         string   `json:"notes,omitempty"`
+	VoterServices string   `json:"voterServices,omitempty"`
+	StartDate     string   `json:"startDate,omitempty"`
+	EndDate       string   `json:"endDate,omitempty"`
+	Latitude      *float64 `json:"latitude,omitempty"`
+	Longitude     *float64 `json:"longitude,omitempty"`
+	MapURL        string   `json:"mapUrl,omitempty"`
+	Sources       []Source `json:"sources,omitempty"`
+}
+
+type Contest struct {
+	Type                     string      `json:"type,omitempty"`
+	BallotTitle              string      `json:"ballotTitle,omitempty"`
+	Office                   string      `json:"office,omitempty"`
+	Levels                   []string    `json:"levels,omitempty"`
+	Roles                    []string    `json:"roles,omitempty"`
+	District                 *District   `json:"district,omitempty"`
+	PrimaryParty             string      `json:"primaryParty,omitempty"`
+	Special                  bool        `json:"special"`
+	ElectorateSpecifications string      `json:"electorateSpecifications,omitempty"`
+	NumberElected            *int        `json:"numberElected,omitempty"`
+	NumberVotingFor          *int        `json:"numberVotingFor,omitempty"`
+	BallotPlacement          *int        `json:"ballotPlacement,omitempty"`
+	Candidates               []Candidate `json:"candidates,omitempty"`
+	Referendum               *Referendum `json:"referendum,omitempty"`
+	Sources                  []Source    `json:"sources,omitempty"`
+}
+
+type District struct {
+	ID    string `json:"id,omitempty"`
+	Name  string `json:"name,omitempty"`
+	Scope string `json:"scope,omitempty"`
+}
+
+type Candidate struct {
+	Name          string    `json:"name"`
+	Party         string    `json:"party,omitempty"`
+	URL           string    `json:"url,omitempty"`
+	Phone         string    `json:"phone,omitempty"`
+	Email         string    `json:"email,omitempty"`
+	PhotoURL      string    `json:"photoUrl,omitempty"`
+	OrderOnBallot int64     `json:"orderOnBallot,omitempty"`
+	Channels      []Channel `json:"channels,omitempty"`
+}
+
+type Channel struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+type Referendum struct {
+	Title            string   `json:"title,omitempty"`
+	Subtitle         string   `json:"subtitle,omitempty"`
+	URL              string   `json:"url,omitempty"`
+	Brief            string   `json:"brief,omitempty"`
+	Text             string   `json:"text,omitempty"`
+	ProStatement     string   `json:"proStatement,omitempty"`
+	ConStatement     string   `json:"conStatement,omitempty"`
+	PassageThreshold string   `json:"passageThreshold,omitempty"`
+	EffectOfAbstain  string   `json:"effectOfAbstain,omitempty"`
+	BallotResponses  []string `json:"ballotResponses,omitempty"`
+}
+
+type State struct {
+	Name              string          `json:"name"`
+	Administration    *Administration `json:"administration,omitempty"`
+	LocalJurisdiction *Jurisdiction   `json:"localJurisdiction,omitempty"`
+	Sources           []Source        `json:"sources,omitempty"`
+}
+
+type Jurisdiction struct {
+	Name           string          `json:"name"`
+	Administration *Administration `json:"administration,omitempty"`
+}
+
+type Administration struct {
+	Name                        string     `json:"name,omitempty"`
+	ElectionInfoURL             string     `json:"electionInfoUrl,omitempty"`
+	RegistrationURL             string     `json:"registrationUrl,omitempty"`
+	RegistrationConfirmationURL string     `json:"registrationConfirmationUrl,omitempty"`
+	AbsenteeVotingInfoURL       string     `json:"absenteeVotingInfoUrl,omitempty"`
+	VotingLocationFinderURL     string     `json:"votingLocationFinderUrl,omitempty"`
+	BallotInfoURL               string     `json:"ballotInfoUrl,omitempty"`
+	ElectionRulesURL            string     `json:"electionRulesUrl,omitempty"`
+	ElectionNoticeText          string     `json:"electionNoticeText,omitempty"`
+	ElectionNoticeURL           string     `json:"electionNoticeUrl,omitempty"`
+	HoursOfOperation            string     `json:"hoursOfOperation,omitempty"`
+	VoterServices               []string   `json:"voterServices,omitempty"`
+	CorrespondenceAddress       *Address   `json:"correspondenceAddress,omitempty"`
+	PhysicalAddress             *Address   `json:"physicalAddress,omitempty"`
+	Officials                   []Official `json:"officials,omitempty"`
+}
+
+type Official struct {
+	Name  string `json:"name"`
+	Title string `json:"title,omitempty"`
+	Phone string `json:"phone,omitempty"`
+	Fax   string `json:"fax,omitempty"`
+	Email string `json:"email,omitempty"`
+}
+
+type Source struct {
+	Name     string `json:"name"`
+	Official bool   `json:"official"`
+}
+
+func fromVoterInfo(d domain.VoterInfo) VoterInfo {
+	return VoterInfo{
+		Election:          fromElection(d.Election),
+		OtherElections:    mapSlice(d.OtherElections, fromElection),
+		MailOnly:          d.MailOnly,
+		PollingLocations:  mapSlice(d.PollingLocations, fromLocation),
+		EarlyVoteSites:    mapSlice(d.EarlyVoteSites, fromLocation),
+		DropOffLocations:  mapSlice(d.DropOffLocations, fromLocation),
+		Contests:          mapSlice(d.Contests, fromContest),
+		States:            mapSlice(d.States, fromState),
+		NormalizedAddress: optionalAddress(d.NormalizedInput),
+	}
+}
+
+func fromElection(e domain.Election) Election {
+	return Election{ID: e.ID, Name: e.Name, Day: formatDate(e.Day), DivisionID: e.DivisionID}
+}
+
+// formatDate writes dates the way the Civic API does, leaving unknown ones
+// blank.
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.DateOnly)
+}
+
+func fromAddress(a domain.Address) Address {
+	return Address{
+		LocationName: a.LocationName,
+		Line1:        a.Line1,
+		Line2:        a.Line2,
+		Line3:        a.Line3,
+		City:         a.City,
+		State:        a.State,
+		Zip:          a.Zip,
+		Formatted:    a.String(),
+	}
+}
+
+func optionalAddress(a domain.Address) *Address {
+	if a == (domain.Address{}) {
+		return nil
+	}
+	out := fromAddress(a)
+	return &out
+}
+
+func fromLocation(p domain.PollingPlace) Location {
+	l := Location{
+		Name:          p.Name,
+		Address:       fromAddress(p.Address),
+		Hours:         p.Hours,
+		Notes:         p.Notes,
+		VoterServices: p.VoterServices,
+		StartDate:     formatDate(p.StartDate),
+		EndDate:       formatDate(p.EndDate),
+		Sources:       mapSlice(p.Sources, fromSource),
+	}
+	// 0,0 is how the Civic API says "no coordinates"
+	if p.Latitude != 0 || p.Longitude != 0 {
+		lat, lng := p.Latitude, p.Longitude
+		l.Latitude, l.Longitude = &lat, &lng
+	}
+	if mapURL, err := p.GetMapsUrl(); err == nil {
+		l.MapURL = mapURL
+	}
+	return l
+}
+
+func fromContest(c domain.Contest) Contest {
+	out := Contest{
+		BallotTitle:              c.BallotTitle,
+		Office:                   c.Office,
+		Levels:                   mapSlice(c.Levels, domain.Level.APIName),
+		Roles:                    c.Roles,
+		PrimaryParty:             c.PrimaryParty,
+		Special:                  c.Special,
+		ElectorateSpecifications: c.ElectorateSpecifications,
+		NumberElected:            optionalCount(c.NumberElected),
+		NumberVotingFor:          optionalCount(c.NumberVotingFor),
+		BallotPlacement:          optionalCount(c.BallotPlacement),
+		Candidates:               mapSlice(c.Candidates, fromCandidate),
+		Sources:                  mapSlice(c.Sources, fromSource),
+	}
+	if c.Type != domain.ContestOther {
+		out.Type = c.Type.String()
+	}
+	if c.District != (domain.District{}) {
+		out.District = &District{ID: c.District.ID, Name: c.District.Name, Scope: c.District.Scope}
+	}
+	if r := c.Referendum; r != nil {
+		out.Referendum = &Referendum{
+			Title:            r.Title,
+			Subtitle:         r.Subtitle,
+			URL:              r.URL,
+			Brief:            r.Brief,
+			Text:             r.Text,
+			ProStatement:     r.ProStatement,
+			ConStatement:     r.ConStatement,
+			PassageThreshold: r.PassageThreshold,
+			EffectOfAbstain:  r.EffectOfAbstain,
+			BallotResponses:  r.BallotResponses,
+		}
+	}
+	return out
+}
+
+// optionalCount omits counts the API didn't send.
+func optionalCount(n int) *int {
+	if n == 0 {
+		return nil
+	}
+	return &n
+}
+
+func fromCandidate(c domain.Candidate) Candidate {
+	return Candidate{
+		Name:          c.Name,
+		Party:         c.Party,
+		URL:           c.URL,
+		Phone:         c.Phone,
+		Email:         c.Email,
+		PhotoURL:      c.PhotoURL,
+		OrderOnBallot: int64(c.OrderOnBallot),
+		Channels: mapSlice(c.Channels, func(ch domain.Channel) Channel {
+			return Channel{Type: ch.Type, ID: ch.ID}
+		}),
+	}
+}
+
+func fromState(s domain.State) State {
+	out := State{
+		Name:           s.Name,
+		Administration: fromAdministration(s.Administration),
+		Sources:        mapSlice(s.Sources, fromSource),
+	}
+	if s.LocalJurisdiction != nil {
+		out.LocalJurisdiction = &Jurisdiction{
+			Name:           s.LocalJurisdiction.Name,
+			Administration: fromAdministration(s.LocalJurisdiction.Administration),
+		}
+	}
+	return out
+}
+
+func fromAdministration(b domain.Administration) *Administration {
+	return &Administration{
+		Name:                        b.Name,
+		ElectionInfoURL:             b.ElectionInfoURL,
+		RegistrationURL:             b.RegistrationURL,
+		RegistrationConfirmationURL: b.RegistrationConfirmationURL,
+		AbsenteeVotingInfoURL:       b.AbsenteeVotingInfoURL,
+		VotingLocationFinderURL:     b.VotingLocationFinderURL,
+		BallotInfoURL:               b.BallotInfoURL,
+		ElectionRulesURL:            b.ElectionRulesURL,
+		ElectionNoticeText:          b.NoticeText,
+		ElectionNoticeURL:           b.NoticeURL,
+		HoursOfOperation:            b.HoursOfOperation,
+		VoterServices:               b.VoterServices,
+		CorrespondenceAddress:       optionalAddress(b.CorrespondenceAddress),
+		PhysicalAddress:             optionalAddress(b.PhysicalAddress),
+		Officials: mapSlice(b.Officials, func(o domain.Official) Official {
+			return Official{Name: o.Name, Title: o.Title, Phone: o.Phone, Fax: o.Fax, Email: o.Email}
+		}),
+	}
+}
+
+func fromSource(s domain.Source) Source {
+	return Source{Name: s.Name, Official: s.Official}
+}
+
+// mapSlice converts every element of in, returning an empty (not nil) slice
+// for empty input so that required lists encode as [] rather than null.
+func mapSlice[T, U any](in []T, f func(T) U) []U {
+	out := make([]U, 0, len(in))
+	for _, v := range in {
+		out = append(out, f(v))
+	}
+	return out
+}
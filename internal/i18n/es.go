@@ -0,0 +1,287 @@
+package i18n
+
+// spanish is the Spanish catalog, written for voters in the United States:
+// "boleta" rather than "papeleta", and the formal "usted" throughout.
+var spanish = map[string]string{
+	// Welcome page and address form
+	"Welcome to govote.sh!": "¡Bienvenido a govote.sh!",
+	"Please enter your address to get election information from the Voting Information Project": "Ingrese su dirección para obtener información electoral del Voting Information Project",
+	"Street Address": "Dirección",
+	"City":           "Ciudad",
+	"State":          "Estado",
+	"Postal Code":    "Código postal",
+	"postal code must be 5 digits (e.g. 23220) or 9 digits (e.g. 23220-1234)": "el código postal debe tener 5 dígitos (p. ej. 23220) o 9 dígitos (p. ej. 23220-1234)",
+	"Remember this address?": "¿Recordar esta dirección?",
+	"Stored encrypted and tied to your SSH key for %d days.\nDelete it any time with: ssh govote.sh forget": "Se guarda cifrada y vinculada a su clave SSH durante %d días.\nBórrela cuando quiera con: ssh govote.sh forget",
+	"Yes":   "Sí",
+	"No":    "No",
+	"Label": "Nombre",
+	"A name to find this address by later, like home or campus": "Un nombre para encontrar esta dirección más tarde, como casa o universidad",
+	"govote.sh has no saved addresses for you.":                 "govote.sh no tiene direcciones guardadas para usted.",
+	"Could not delete your saved addresses, please try again.":  "No se pudieron borrar sus direcciones guardadas, inténtelo de nuevo.",
+	"Your saved addresses have been deleted.":                   "Se borraron sus direcciones guardadas.",
+	"Address not saved: delete a saved one to make room.":       "No se guardó la dirección: borre una guardada para hacer espacio.",
+	"Could not update your saved addresses.":                    "No se pudieron actualizar sus direcciones guardadas.",
+	"Loading election information, please wait...":              "Cargando la información electoral, espere un momento...",
+	"Plain text for screen readers":                             "Texto simple para lectores de pantalla",
+	"Full screen":                                               "Pantalla completa",
+	"Colors: %s":                                                "Colores: %s",
+	"Automatic (%s)":                                            "Automáticos (%s)",
+	"Default":                                                   "Predeterminados",
+	"High contrast":                                             "Alto contraste",
+	"Light":                                                     "Claros",
+	"Colorblind-safe":                                           "Aptos para daltonismo",
+
+	// Lookup errors
+	"Error: unknown error": "Error: error desconocido",
+	"Error: Client error (code: %d): This is likely due to an invalid address\nor the voter information project not being up to date\nPlease check https://all.votinginfotool.org": "Error: error del cliente (código: %d): probablemente la dirección no es válida\no el Voting Information Project no está actualizado\nConsulte https://all.votinginfotool.org",
+	"Error: Server error (code: %d): This is likely due to the API being down\nPlease check https://all.votinginfotool.org to make sure":                                           "Error: error del servidor (código: %d): probablemente el servicio no está disponible\nConsulte https://all.votinginfotool.org para confirmarlo",
+	"Error: %v":                                        "Error: %v",
+	"at least one address field is required":           "se necesita al menos un dato de la dirección",
+	"could not reach the election information service": "no se pudo conectar con el servicio de información electoral",
+	"could not extract election day from response":     "no se pudo obtener el día de las elecciones de la respuesta",
+	"could not parse endpoint URL":                     "la dirección del servicio no es válida",
+
+	// Address confirmation
+	"Is this the right address?": "¿Es esta la dirección correcta?",
+	"We matched:":                "Encontramos:",
+	"(you entered %q)":           "(usted escribió %q)",
+	"(added)":                    "(agregado)",
+	"Highlighted fields were changed by the Voting Information Project.": "Los datos resaltados los cambió el Voting Information Project.",
+
+	// Saved addresses
+	"Saved Addresses": "Direcciones guardadas",
+
+	// Header
+	"Back":      "Volver",
+	"Vote":      "Votar",
+	"Contests":  "Contiendas",
+	"Register":  "Inscripción",
+	"Timeline":  "Calendario",
+	"Addresses": "Direcciones",
+	"Menu:":     "Menú:",
+
+	// Dates
+	"today":             "hoy",
+	"tomorrow":          "mañana",
+	"yesterday":         "ayer",
+	"in %d days":        "en %d días",
+	"%d days ago":       "hace %d días",
+	"starts %s":         "abre %s",
+	"ended %s":          "cerró %s",
+	"open now, ends %s": "abierto ahora, cierra %s",
+	"open now":          "abierto ahora",
+
+	// Vote page
+	"Polling Locations":  "Lugares de votación",
+	"Early Voting Sites": "Votación anticipada",
+	"Drop Off Locations": "Lugares para entregar la boleta",
+	"building list...":   "preparando la lista...",
+	"Use tab to cycle through the lists of voting options": "Use Tab para pasar de una lista de opciones de votación a otra",
+	"Closed":                 "Cerrado",
+	"Closed %s":              "Cerró %s",
+	"This election is over.": "Esta elección ya terminó.",
+	"The Voting Information Project also has information on:":                               "El Voting Information Project también tiene información sobre:",
+	"Check back closer to the next election for updated information.":                       "Vuelva cuando se acerque la próxima elección para ver información actualizada.",
+	"Some details from the Voting Information Project could not be read and are not shown.": "No se pudieron leer algunos datos del Voting Information Project y no se muestran.",
+	"This election is held by mail: a ballot is mailed to every registered voter.":          "Esta elección es por correo: se envía una boleta a cada votante inscrito.",
+	"Return it by mail or at a drop-off location by %s (%s).":                               "Devuélvala por correo o en un lugar de entrega a más tardar el %s (%s).",
+	"Return it by mail or at a drop-off location by election day.":                          "Devuélvala por correo o en un lugar de entrega a más tardar el día de las elecciones.",
+	"Mailed ballots may have to be postmarked or received by a deadline; check the rules:":  "Es posible que la boleta deba tener matasellos o llegar antes de una fecha límite; consulte las reglas:",
+	"Absentee voting": "Voto en ausencia",
+	"Ballot info":     "Información sobre la boleta",
+
+	// Polling place page
+	"No polling place selected":  "No se seleccionó ningún lugar de votación",
+	"Invalid polling place data": "Los datos del lugar de votación no son válidos",
+	"Polling Place Details":      "Detalles del lugar de votación",
+	"Day":                        "Día",
+	"Hours":                      "Horario",
+	"Notes:":                     "Notas:",
+	"Voter Services:":            "Servicios para votantes:",
+	"Date":                       "Fecha",
+	"Until":                      "Hasta",
+	"From":                       "Desde",
+	"Available Dates":            "Fechas disponibles",
+	"Map link:":                  "Enlace al mapa:",
+
+	// Contests page
+	"Ballot Measures":                "Medidas electorales",
+	"Other":                          "Otros",
+	"International":                  "Internacional",
+	"Federal":                        "Federal",
+	"Regional":                       "Regional",
+	"County":                         "Condado",
+	"Sub-locality":                   "Sublocalidad",
+	"Special District":               "Distrito especial",
+	"%d contest":                     "%d contienda",
+	"%d contests":                    "%d contiendas",
+	"%d measure":                     "%d medida",
+	"%d measures":                    "%d medidas",
+	"1 candidate":                    "1 candidato",
+	"%d candidates":                  "%d candidatos",
+	"(Special Election)":             "(Elección especial)",
+	"%s primary":                     "Primaria %s",
+	"(folded)":                       "(cerrada)",
+	"Every party's primary contests": "Las primarias de todos los partidos",
+	"%s ballot: %s primary and nonpartisan contests": "Boleta %s: primaria %s y contiendas no partidistas",
+	"Choose party ballot":                            "Elegir la boleta de un partido",
+	"No contests available...":                       "No hay contiendas disponibles...",
+
+	// Contest page
+	"No contest selected":            "No se seleccionó ninguna contienda",
+	"Invalid contest data":           "Los datos de la contienda no son válidos",
+	"Contest Details":                "Detalles de la contienda",
+	"Ballot Title":                   "Título en la boleta",
+	"Office":                         "Cargo",
+	"Primary":                        "Primaria",
+	"%s voters choose their nominee": "Los votantes %s eligen a su candidato",
+	"Special election: this seat is being filled outside the regular schedule": "Elección especial: este cargo se cubre fuera del calendario habitual",
+	"Number Elected":            "Número de electos",
+	"Ballot Placement":          "Posición en la boleta",
+	"Electorate Specifications": "Requisitos del electorado",
+	"Candidates":                "Candidatos",
+	"Name":                      "Nombre",
+	"Party":                     "Partido",
+	"(marked)":                  "(marcado)",
+
+	// Compare page
+	"Mark two or more candidates to compare them": "Marque dos o más candidatos para compararlos",
+	"Comparing candidates for %s":                 "Comparación de candidatos para %s",
+	"Website":                                     "Sitio web",
+	"Email":                                       "Correo electrónico",
+	"Phone":                                       "Teléfono",
+	"Ballot Order":                                "Orden en la boleta",
+
+	// Ballot measure page
+	"Choices":                    "Opciones",
+	"Passes with":                "Se aprueba con",
+	"If you don't vote on it":    "Si no vota en esta medida",
+	"For":                        "A favor",
+	"Against":                    "En contra",
+	"Full Text":                  "Texto completo",
+	"More information":           "Más información",
+	"No ballot measure selected": "No se seleccionó ninguna medida electoral",
+
+	// Register page
+	"State: %s":                              "Estado: %s",
+	"Register in %s":                         "Inscríbase en %s",
+	"Local: %s":                              "Local: %s",
+	"Local Jurisdiction: %s":                 "Jurisdicción local: %s",
+	"Election Administration":                "Administración electoral",
+	"Election Info":                          "Información electoral",
+	"Registration URL":                       "Enlace de inscripción",
+	"Confirmation URL":                       "Confirmar la inscripción",
+	"Absentee Voting Info":                   "Voto en ausencia",
+	"Location Finder":                        "Buscar lugares de votación",
+	"Ballot Info":                            "Información sobre la boleta",
+	"Election Rules":                         "Reglas electorales",
+	"Hours of Operation":                     "Horario de atención",
+	"Voter Services":                         "Servicios para votantes",
+	"Correspondence Address":                 "Dirección postal",
+	"Physical Address":                       "Dirección física",
+	"Election Officials: %s":                 "Funcionarios electorales: %s",
+	"No registration information available.": "No hay información de inscripción disponible.",
+	"(shown)":                                "(mostrada)",
+	"No election officials listed":           "No hay funcionarios electorales",
+	"No official selected":                   "No se seleccionó ningún funcionario",
+	"Title":                                  "Cargo",
+	"Office Phone":                           "Teléfono de la oficina",
+	"Fax":                                    "Fax",
+
+	// Timeline page
+	"Register to vote":         "Inscribirse para votar",
+	"Check the deadline at %s": "Consulte la fecha límite en %s",
+	"Early voting opens":       "Empieza la votación anticipada",
+	"Early voting ends":        "Termina la votación anticipada",
+	"Drop off a ballot at %s":  "Entregar la boleta en %s",
+	"Until %s":                 "Hasta el %s",
+	"Election day":             "Día de las elecciones",
+	"Election day: last day to return your ballot": "Día de las elecciones: último día para devolver su boleta",
+	"No dates available for this election...":      "No hay fechas disponibles para esta elección...",
+
+	// Notices
+	"Read full notice": "Leer el aviso completo",
+	"Dismiss":          "Descartar",
+	"Notice from %s":   "Aviso de %s",
+
+	// Search page
+	"search all": "buscar en todo",
+	"Contests, candidates, ballot measures, places...":                  "Contiendas, candidatos, medidas electorales, lugares...",
+	"Search contests, candidates, ballot measures and voting locations": "Busque contiendas, candidatos, medidas electorales y lugares de votación",
+	"No matches": "No hay resultados",
+
+	// Key help from lists and forms, and the results pages' help
+	"item":         "elemento",
+	"items":        "elementos",
+	"Filter: ":     "Filtrar: ",
+	"up":           "subir",
+	"down":         "bajar",
+	"prev page":    "página anterior",
+	"next page":    "página siguiente",
+	"go to start":  "ir al inicio",
+	"go to end":    "ir al final",
+	"filter":       "filtrar",
+	"clear filter": "quitar filtro",
+	"cancel":       "cancelar",
+	"apply filter": "aplicar filtro",
+	"more":         "más",
+	"close help":   "cerrar ayuda",
+	"quit":         "salir",
+	"back":         "atrás",
+	"next":         "siguiente",
+	"submit":       "enviar",
+	"complete":     "completar",
+	"toggle":       "cambiar",
+
+	"vote":                          "votar",
+	"contests":                      "contiendas",
+	"register":                      "inscribirse",
+	"timeline":                      "calendario",
+	"saved addresses":               "direcciones guardadas",
+	"next tab":                      "pestaña siguiente",
+	"previous tab":                  "pestaña anterior",
+	"read notices":                  "leer avisos",
+	"dismiss notices":               "descartar avisos",
+	"open a page":                   "abrir una página",
+	"more keys":                     "más teclas",
+	"language":                      "idioma",
+	"plain text":                    "texto simple",
+	"full screen":                   "pantalla completa",
+	"colors":                        "colores",
+	"details":                       "detalles",
+	"next list":                     "lista siguiente",
+	"previous list":                 "lista anterior",
+	"closed sites":                  "lugares cerrados",
+	"hide closed sites":             "ocultar los lugares cerrados",
+	"show %d closed":                "mostrar %d cerrados",
+	"fold section":                  "plegar sección",
+	"party ballot":                  "boleta del partido",
+	"mark to compare":               "marcar para comparar",
+	"mark to compare (%d marked)":   "marcar para comparar (%d marcados)",
+	"compare":                       "comparar",
+	"earlier candidates":            "candidatos anteriores",
+	"later candidates":              "candidatos siguientes",
+	"more candidates":               "más candidatos",
+	"more candidates (%d–%d of %d)": "más candidatos (%d–%d de %d)",
+	"next office":                   "siguiente oficina",
+	"previous office":               "oficina anterior",
+	"election officials":            "funcionarios electorales",
+	"election officials (%d)":       "funcionarios electorales (%d)",
+	"scroll":                        "desplazarse",
+	"looks right":                   "es correcta",
+	"edit address":                  "editar la dirección",
+	"start over":                    "empezar de nuevo",
+	"look up":                       "consultar",
+	"new address":                   "dirección nueva",
+	"delete":                        "borrar",
+	"back to results":               "volver a los resultados",
+	"forget all":                    "olvidar todas",
+	"keep":                          "conservar",
+	"Delete all %d saved addresses? This can't be undone.": "¿Borrar las %d direcciones guardadas? No se puede deshacer.",
+	"Delete the saved address %q? This can't be undone.":   "¿Borrar la dirección guardada %q? No se puede deshacer.",
+	"Copied %s": "Copiado: %s",
+	"This page": "Esta página",
+	"Pages":     "Páginas",
+	"Anywhere":  "En cualquier lugar",
+}
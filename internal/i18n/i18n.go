@@ -0,0 +1,117 @@
+// Package i18n translates the interface into the languages govote speaks.
+// Messages are looked up by their English text, so English needs no
+// catalog and anything missing from another language's falls back to
+// English rather than showing a placeholder.
+package i18n
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Locale is a language the interface can be shown in, by its ISO 639-1 code.
+type Locale string
+
+const (
+	English Locale = "en"
+	Spanish Locale = "es"
+)
+
+// Locales are the supported languages, in the order the in-app toggle
+// cycles through them.
+var Locales = []Locale{English, Spanish}
+
+// catalogs hold each language's translations, keyed by the English text.
+var catalogs = map[Locale]map[string]string{
+	Spanish: spanish,
+}
+
+// Parse reads a language from a locale name like "es", "es-MX" or the
+// POSIX "es_US.UTF-8", ignoring the region and encoding.
+func Parse(name string) (Locale, bool) {
+	lang, _, _ := strings.Cut(name, ".")
+	lang, _, _ = strings.Cut(lang, "@")
+	lang, _, _ = strings.Cut(strings.ReplaceAll(lang, "-", "_"), "_")
+	lang = strings.ToLower(lang)
+	for _, l := range Locales {
+		if string(l) == lang {
+			return l, true
+		}
+	}
+	return English, false
+}
+
+// FromEnv picks the language from a session's environment, in "NAME=value"
+// form. The first of LC_ALL, LC_MESSAGES and LANG that's set decides, as
+// it would for a local program. Unsupported languages fall back to English.
+func FromEnv(environ []string) Locale {
+	vars := map[string]string{}
+	for _, kv := range environ {
+		if name, value, ok := strings.Cut(kv, "="); ok {
+			vars[name] = value
+		}
+	}
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if value := vars[name]; value != "" {
+			l, _ := Parse(value)
+			return l
+		}
+	}
+	return English
+}
+
+// Next is the language after l, for the in-app toggle.
+func (l Locale) Next() Locale {
+	for i, other := range Locales {
+		if other == l {
+			return Locales[(i+1)%len(Locales)]
+		}
+	}
+	return English
+}
+
+// Name is the language's name in that language, e.g. "Español".
+func (l Locale) Name() string {
+	if l == Spanish {
+		return "Español"
+	}
+	return "English"
+}
+
+// T translates msg, or returns it as is if there's no translation.
+func (l Locale) T(msg string) string {
+	if translated, ok := catalogs[l][msg]; ok {
+		return translated
+	}
+	return msg
+}
+
+// Has reports whether msg has a translation in l. Every message has one in
+// English.
+func (l Locale) Has(msg string) bool {
+	if l == English {
+		return true
+	}
+	_, ok := catalogs[l][msg]
+	return ok
+}
+
+// Tf translates a format string and formats it with args.
+func (l Locale) Tf(format string, args ...any) string {
+	return fmt.Sprintf(l.T(format), args...)
+}
+
+var (
+	spanishWeekdays = [...]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"}
+	spanishMonths   = [...]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"}
+)
+
+// Date renders a calendar day in full, like "Tuesday, November 5, 2024" or
+// "martes, 5 de noviembre de 2024".
+func (l Locale) Date(day time.Time) string {
+	if l == Spanish {
+		return fmt.Sprintf("%s, %d de %s de %d", spanishWeekdays[day.Weekday()], day.Day(), spanishMonths[day.Month()-1], day.Year())
+	}
+	return day.Format("Monday, January 2, 2006")
+}
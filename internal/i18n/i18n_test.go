@@ -0,0 +1,88 @@
+package i18n
+
+import (
+	"regexp"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		want Locale
+		ok   bool
+	}{
+		{"es", Spanish, true},
+		{"es_US.UTF-8", Spanish, true},
+		{"es-MX", Spanish, true},
+		{"ES", Spanish, true},
+		{"en_US.UTF-8", English, true},
+		{"C", English, false},
+		{"fr_FR.UTF-8", English, false},
+		{"", English, false},
+	}
+	for _, tt := range tests {
+		if got, ok := Parse(tt.name); got != tt.want || ok != tt.ok {
+			t.Errorf("Parse(%q) = %q, %v, want %q, %v", tt.name, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestFromEnv(t *testing.T) {
+	tests := []struct {
+		environ []string
+		want    Locale
+	}{
+		{nil, English},
+		{[]string{"LANG=es_US.UTF-8"}, Spanish},
+		{[]string{"LANG=es_US.UTF-8", "LC_ALL=en_US.UTF-8"}, English},
+		{[]string{"LANG=en_US.UTF-8", "LC_MESSAGES=es_MX.UTF-8"}, Spanish},
+		{[]string{"LC_ALL=", "LANG=es"}, Spanish},
+		{[]string{"LANG=fr_FR.UTF-8"}, English},
+	}
+	for _, tt := range tests {
+		if got := FromEnv(tt.environ); got != tt.want {
+			t.Errorf("FromEnv(%q) = %q, want %q", tt.environ, got, tt.want)
+		}
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	if got := Spanish.Tf("in %d days", 3); got != "en 3 días" {
+		t.Errorf("Spanish.Tf = %q", got)
+	}
+	if got := English.Tf("in %d days", 3); got != "in 3 days" {
+		t.Errorf("English.Tf = %q", got)
+	}
+	// Anything missing from a catalog is shown in English
+	if got := Spanish.T("Not in any catalog"); got != "Not in any catalog" {
+		t.Errorf("Spanish.T = %q", got)
+	}
+	if English.Next() != Spanish || Spanish.Next() != English {
+		t.Error("Next doesn't cycle through the languages")
+	}
+}
+
+func TestDate(t *testing.T) {
+	day := time.Date(2026, 11, 3, 0, 0, 0, 0, time.UTC)
+	if got := English.Date(day); got != "Tuesday, November 3, 2026" {
+		t.Errorf("English.Date = %q", got)
+	}
+	if got := Spanish.Date(day); got != "martes, 3 de noviembre de 2026" {
+		t.Errorf("Spanish.Date = %q", got)
+	}
+}
+
+// A translation that drops or reorders a format verb would garble the
+// message, or worse, panic with the wrong type
+func TestCatalogsKeepFormatVerbs(t *testing.T) {
+	verb := regexp.MustCompile(`%[-+# 0-9.]*[a-zA-Z%]`)
+	for l, catalog := range catalogs {
+		for msg, translated := range catalog {
+			if want, got := verb.FindAllString(msg, -1), verb.FindAllString(translated, -1); !slices.Equal(got, want) {
+				t.Errorf("%s: %q has verbs %q, want %q like %q", l, translated, got, want, msg)
+			}
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package listManager
 
 import (
+	"charm.land/bubbles/v2/key"
 	"charm.land/bubbles/v2/list"
 	tea "charm.land/bubbletea/v2"
 )
@@ -72,3 +73,53 @@ func (lm *ListManager) SelectedItem() list.Item {
 	item := lm.lists[lm.activeIndex].SelectedItem()
 	return item
 }
+
+// SetDelegate changes how items are rendered in every list
+func (lm *ListManager) SetDelegate(d list.ItemDelegate) {
+	for i := range lm.lists {
+		lm.lists[i].SetDelegate(d)
+	}
+}
+
+// SetItems replaces the items of the list at index, keeping which list is active
+func (lm *ListManager) SetItems(index int, items []list.Item) tea.Cmd {
+	return lm.lists[index].SetItems(items)
+}
+
+// SetActive makes the list at index the active one
+func (lm *ListManager) SetActive(index int) {
+	if index >= 0 && index < len(lm.lists) {
+		lm.activeIndex = index
+	}
+}
+
+// Select selects the item at index in the active list
+func (lm *ListManager) Select(index int) {
+	lm.lists[lm.activeIndex].Select(index)
+}
+
+// SetFilteringEnabled turns each list's own filter on or off
+func (lm *ListManager) SetFilteringEnabled(enabled bool) {
+	for i := range lm.lists {
+		lm.lists[i].SetFilteringEnabled(enabled)
+	}
+}
+
+// SetAdditionalShortHelpKeys adds keys to every list's short help
+func (lm *ListManager) SetAdditionalShortHelpKeys(keys func() []key.Binding) {
+	for i := range lm.lists {
+		lm.lists[i].AdditionalShortHelpKeys = keys
+	}
+}
+
+// Active returns the index of the active list
+func (lm *ListManager) Active() int {
+	return lm.activeIndex
+}
+
+// Apply calls fn on every list, for settings without a method of their own
+func (lm *ListManager) Apply(fn func(l *list.Model)) {
+	for i := range lm.lists {
+		fn(&lm.lists[i])
+	}
+}
@@ -1,11 +1,20 @@
 package secrets
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"os"
 )
 
-var apiKey string
+var (
+	apiKey   string
+	storeKey []byte
+)
+
+// ErrStoreKeyNotSet is returned by GetStoreKey when STORE_KEY is unset,
+// which disables saving addresses.
+var ErrStoreKeyNotSet = errors.New("STORE_KEY environment variable is not set")
 
 // SetupSecrets loads the API key from the environment and caches it.
 // This function should be called once during application startup.
@@ -14,9 +23,30 @@ func SetupSecrets() error {
 	if apiKey == "" {
 		return errors.New("API_KEY environment variable is not set")
 	}
+
+	// STORE_KEY is optional: without it, addresses are never saved
+	storeKey = nil
+	if encoded := os.Getenv("STORE_KEY"); encoded != "" {
+		key, err := decodeKey(encoded)
+		if err != nil {
+			return err
+		}
+		storeKey = key
+	}
 	return nil
 }
 
+// decodeKey accepts a 32-byte key as hex or standard base64.
+func decodeKey(encoded string) ([]byte, error) {
+	if key, err := hex.DecodeString(encoded); err == nil && len(key) == 32 {
+		return key, nil
+	}
+	if key, err := base64.StdEncoding.DecodeString(encoded); err == nil && len(key) == 32 {
+		return key, nil
+	}
+	return nil, errors.New("STORE_KEY must be 32 bytes, hex or base64 encoded")
+}
+
 // GetAPIKey retrieves the cached API key.
 func GetAPIKey() (string, error) {
 	if apiKey == "" {
@@ -24,3 +54,11 @@ func GetAPIKey() (string, error) {
 	}
 	return apiKey, nil
 }
+
+// GetStoreKey retrieves the cached key used to encrypt saved addresses.
+func GetStoreKey() ([]byte, error) {
+	if storeKey == nil {
+		return nil, ErrStoreKeyNotSet
+	}
+	return storeKey, nil
+}
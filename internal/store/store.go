@@ -0,0 +1,377 @@
+// Package store keeps the addresses users have asked govote to remember.
+// Each user can save several, under labels like "home" or "campus".
+// Entries are keyed by a keyed hash of the user's SSH public key fingerprint
+// and encrypted at rest, so neither the file names nor their contents reveal
+// who a file belongs to or where they live without the server's key.
+package store
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/govote-sh/govote/internal/address"
+	gossh "golang.org/x/crypto/ssh"
+)
+
+// ErrNotFound is returned when a user has no saved address, including when
+// their saved address has outlived the retention window.
+var ErrNotFound = errors.New("no saved address")
+
+var errInvalidID = errors.New("invalid user ID")
+
+// MaxAddresses caps how many addresses one user can save.
+const MaxAddresses = 100
+
+// ErrTooManyAddresses is returned by Save when a new label would take the
+// user past MaxAddresses.
+var ErrTooManyAddresses = fmt.Errorf("at most %d addresses can be saved", MaxAddresses)
+
+// SavedAddress is one labelled address.
+type SavedAddress struct {
+	Label   string               `json:"label"`
+	Address address.InputAddress `json:"address"`
+}
+
+// Entry is what gets stored for a user.
+type Entry struct {
+	Addresses []SavedAddress `json:"addresses"`
+	SavedAt   time.Time      `json:"savedAt"`
+
+	// Address is the single unlabelled address entries held before users
+	// could save several. Load moves it into Addresses.
+	Address *address.InputAddress `json:"address,omitempty"`
+}
+
+// Store is a directory of encrypted entries, one file per user.
+type Store struct {
+	dir       string
+	key       []byte
+	retention time.Duration
+	now       func() time.Time
+
+	// mu serializes read-modify-write of entries, since one user can have
+	// several sessions open
+	mu sync.Mutex
+}
+
+// New opens the store in dir, creating it if needed. key must be 32 bytes.
+// Entries older than retention are treated as gone and deleted.
+func New(dir string, key []byte, retention time.Duration) (*Store, error) {
+	if len(key) != 32 {
+		return nil, errors.New("store key must be 32 bytes")
+	}
+	if retention <= 0 {
+		return nil, errors.New("retention must be positive")
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("creating store directory: %w", err)
+	}
+	return &Store{dir: dir, key: key, retention: retention, now: time.Now}, nil
+}
+
+// Retention is how long an entry lives after it was last saved.
+func (s *Store) Retention() time.Duration {
+	return s.retention
+}
+
+// UserID derives the opaque ID a user's entry is stored under. It is an HMAC
+// rather than a plain hash because public keys are public: anyone could hash
+// a known key and look for its file.
+func (s *Store) UserID(pk gossh.PublicKey) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(gossh.FingerprintSHA256(pk)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Load returns the user's saved entry, or ErrNotFound.
+func (s *Store) Load(id string) (Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.load(id)
+}
+
+func (s *Store) load(id string) (Entry, error) {
+	entry, err := s.read(id)
+	if err != nil {
+		return Entry{}, err
+	}
+	if s.expired(entry) || len(entry.Addresses) == 0 {
+		if err := s.forget(id); err != nil {
+			return Entry{}, err
+		}
+		return Entry{}, ErrNotFound
+	}
+	return entry, nil
+}
+
+// read decrypts and decodes the user's entry, expired or not.
+func (s *Store) read(id string) (Entry, error) {
+	if !isUserID(id) {
+		return Entry{}, errInvalidID
+	}
+	sealed, err := os.ReadFile(s.path(id))
+	if errors.Is(err, fs.ErrNotExist) {
+		return Entry{}, ErrNotFound
+	} else if err != nil {
+		return Entry{}, fmt.Errorf("reading saved address: %w", err)
+	}
+
+	plain, err := s.open(id, sealed)
+	if err != nil {
+		return Entry{}, err
+	}
+	var entry Entry
+	if err := json.Unmarshal(plain, &entry); err != nil {
+		return Entry{}, fmt.Errorf("decoding saved address: %w", err)
+	}
+	if entry.Address != nil {
+		entry.Addresses = append(entry.Addresses, SavedAddress{Label: "home", Address: *entry.Address})
+		entry.Address = nil
+	}
+	return entry, nil
+}
+
+// Save stores addr for the user under label, replacing any address already
+// saved under that label, and restarts the retention window. It returns the
+// user's updated entry.
+func (s *Store) Save(id, label string, addr address.InputAddress) (Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, err := s.load(id)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return Entry{}, err
+	}
+	i := indexOfLabel(entry.Addresses, label)
+	switch {
+	case i >= 0:
+		entry.Addresses[i].Address = addr
+	case len(entry.Addresses) >= MaxAddresses:
+		return Entry{}, ErrTooManyAddresses
+	default:
+		entry.Addresses = append(entry.Addresses, SavedAddress{Label: label, Address: addr})
+	}
+	entry.SavedAt = s.now()
+	if err := s.write(id, entry); err != nil {
+		return Entry{}, err
+	}
+	return entry, nil
+}
+
+// Remove deletes the address saved under label. Removing the last address
+// removes the user's entry entirely.
+func (s *Store) Remove(id, label string) (Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, err := s.load(id)
+	if err != nil {
+		return Entry{}, err
+	}
+	i := indexOfLabel(entry.Addresses, label)
+	if i < 0 {
+		return entry, nil
+	}
+	entry.Addresses = append(entry.Addresses[:i], entry.Addresses[i+1:]...)
+	if len(entry.Addresses) == 0 {
+		return Entry{}, s.forget(id)
+	}
+	if err := s.write(id, entry); err != nil {
+		return Entry{}, err
+	}
+	return entry, nil
+}
+
+func indexOfLabel(addresses []SavedAddress, label string) int {
+	for i, saved := range addresses {
+		if strings.EqualFold(saved.Label, label) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *Store) write(id string, entry Entry) error {
+	plain, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("encoding address: %w", err)
+	}
+	sealed, err := s.seal(id, plain)
+	if err != nil {
+		return err
+	}
+
+	// Write then rename so a crash never leaves a truncated entry behind
+	tmp, err := os.CreateTemp(s.dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("saving address: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err := tmp.Write(sealed); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("saving address: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("saving address: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path(id)); err != nil {
+		return fmt.Errorf("saving address: %w", err)
+	}
+	return nil
+}
+
+// Forget deletes the user's entry and every address in it. Forgetting a
+// user with nothing saved is not an error.
+func (s *Store) Forget(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.forget(id)
+}
+
+func (s *Store) forget(id string) error {
+	if !isUserID(id) {
+		return errInvalidID
+	}
+	if err := os.Remove(s.path(id)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("deleting saved address: %w", err)
+	}
+	return nil
+}
+
+// Sweep deletes every entry past the retention window, returning how many
+// it removed. An entry that can't be read, such as one sealed with an old
+// store key, goes by when its file was last written instead; the errors
+// reading those are returned along with the count.
+func (s *Store) Sweep() (int, error) {
+	dirEntries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return 0, fmt.Errorf("listing store directory: %w", err)
+	}
+	removed := 0
+	var errs []error
+	for _, dirEntry := range dirEntries {
+		id := dirEntry.Name()
+		if dirEntry.IsDir() || !isUserID(id) {
+			continue
+		}
+		swept, err := s.sweep(id)
+		if swept {
+			removed++
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("entry %.8s: %w", id, err))
+		}
+	}
+	return removed, errors.Join(errs...)
+}
+
+// sweep deletes the user's entry if it's past the retention window,
+// reporting whether it did.
+func (s *Store) sweep(id string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, readErr := s.read(id)
+	switch {
+	case errors.Is(readErr, ErrNotFound):
+		return false, nil
+	case readErr != nil:
+		info, err := os.Stat(s.path(id))
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		} else if err != nil {
+			return false, errors.Join(readErr, err)
+		}
+		if s.now().Sub(info.ModTime()) <= s.retention {
+			return false, readErr
+		}
+	case !s.expired(entry) && len(entry.Addresses) > 0:
+		return false, nil
+	}
+
+	if err := os.Remove(s.path(id)); errors.Is(err, fs.ErrNotExist) {
+		return false, readErr
+	} else if err != nil {
+		return false, errors.Join(readErr, fmt.Errorf("deleting saved address: %w", err))
+	}
+	return true, readErr
+}
+
+func (s *Store) expired(entry Entry) bool {
+	return s.now().Sub(entry.SavedAt) > s.retention
+}
+
+func (s *Store) path(id string) string {
+	return filepath.Join(s.dir, id)
+}
+
+// isUserID reports whether name looks like an ID from UserID, which also
+// guards path() against anything that could escape the store directory.
+func isUserID(name string) bool {
+	b, err := hex.DecodeString(name)
+	return err == nil && len(b) == sha256.Size
+}
+
+// aead returns the cipher for one user's entry. Each user gets their own key
+// derived from the store key, and the ID is bound in as additional data so
+// an entry copied to another user's file fails to decrypt.
+func (s *Store) aead(id string) (cipher.AEAD, error) {
+	if !isUserID(id) {
+		return nil, errInvalidID
+	}
+	userKey, err := hkdf.Key(sha256.New, s.key, nil, "govote store "+id, 32)
+	if err != nil {
+		return nil, fmt.Errorf("deriving user key: %w", err)
+	}
+	block, err := aes.NewCipher(userKey)
+	if err != nil {
+		return nil, fmt.Errorf("creating cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("creating cipher: %w", err)
+	}
+	return gcm, nil
+}
+
+func (s *Store) seal(id string, plain []byte) ([]byte, error) {
+	gcm, err := s.aead(id)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generating nonce: %w", err)
+	}
+	return gcm.Seal(nonce, nonce, plain, []byte(id)), nil
+}
+
+func (s *Store) open(id string, sealed []byte) ([]byte, error) {
+	gcm, err := s.aead(id)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, errors.New("saved address is corrupt")
+	}
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	plain, err := gcm.Open(nil, nonce, ciphertext, []byte(id))
+	if err != nil {
+		return nil, fmt.Errorf("decrypting saved address: %w", err)
+	}
+	return plain, nil
+}
@@ -0,0 +1,214 @@
+package store
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/govote-sh/govote/internal/address"
+	gossh "golang.org/x/crypto/ssh"
+)
+
+var testAddress = address.InputAddress{Street: "1234 W Broad St", City: "Richmond", State: "VA", PostalCode: "23220"}
+
+func newTestStore(t *testing.T) *Store {
+	t.Helper()
+	st, err := New(t.TempDir(), bytes.Repeat([]byte{7}, 32), 24*time.Hour)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return st
+}
+
+func newTestKey(t *testing.T) gossh.PublicKey {
+	t.Helper()
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	pk, err := gossh.NewPublicKey(pub)
+	if err != nil {
+		t.Fatalf("NewPublicKey: %v", err)
+	}
+	return pk
+}
+
+func TestSaveLoadForget(t *testing.T) {
+	st := newTestStore(t)
+	id := st.UserID(newTestKey(t))
+
+	if _, err := st.Load(id); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Load before Save: err = %v, want ErrNotFound", err)
+	}
+	if _, err := st.Save(id, "home", testAddress); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	entry, err := st.Load(id)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if want := []SavedAddress{{Label: "home", Address: testAddress}}; !slices.Equal(entry.Addresses, want) {
+		t.Errorf("Load().Addresses = %+v, want %+v", entry.Addresses, want)
+	}
+
+	if err := st.Forget(id); err != nil {
+		t.Fatalf("Forget: %v", err)
+	}
+	if _, err := st.Load(id); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Load after Forget: err = %v, want ErrNotFound", err)
+	}
+	if err := st.Forget(id); err != nil {
+		t.Errorf("second Forget: %v", err)
+	}
+}
+
+func TestEntriesAreEncryptedAndBoundToTheirUser(t *testing.T) {
+	st := newTestStore(t)
+	pk := newTestKey(t)
+	id, otherID := st.UserID(pk), st.UserID(newTestKey(t))
+	if _, err := st.Save(id, "home", testAddress); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	sealed, err := os.ReadFile(filepath.Join(st.dir, id))
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	if bytes.Contains(sealed, []byte("Broad")) {
+		t.Error("entry on disk contains the plaintext address")
+	}
+	if bytes.Contains([]byte(id), []byte(gossh.FingerprintSHA256(pk))) {
+		t.Error("user ID contains the public key fingerprint")
+	}
+
+	// Another user's file holding this ciphertext must not decrypt
+	if err := os.WriteFile(filepath.Join(st.dir, otherID), sealed, 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	if _, err := st.Load(otherID); err == nil || errors.Is(err, ErrNotFound) {
+		t.Errorf("Load of copied entry: err = %v, want a decryption error", err)
+	}
+}
+
+func TestExpiredEntriesAreDeleted(t *testing.T) {
+	st := newTestStore(t)
+	id := st.UserID(newTestKey(t))
+	if _, err := st.Save(id, "home", testAddress); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	st.now = func() time.Time { return time.Now().Add(25 * time.Hour) }
+	removed, err := st.Sweep()
+	if err != nil {
+		t.Fatalf("Sweep: %v", err)
+	}
+	if removed != 1 {
+		t.Errorf("Sweep removed %d entries, want 1", removed)
+	}
+	if _, err := os.Stat(filepath.Join(st.dir, id)); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expired entry still on disk: stat err = %v", err)
+	}
+}
+
+func TestSweepDeletesUnreadableEntriesByAge(t *testing.T) {
+	old := newTestStore(t)
+	id := old.UserID(newTestKey(t))
+	if _, err := old.Save(id, "home", testAddress); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	// The store key rotated, so the entry can no longer be opened
+	st, err := New(old.dir, bytes.Repeat([]byte{9}, 32), 24*time.Hour)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	removed, err := st.Sweep()
+	if removed != 0 || err == nil {
+		t.Errorf("Sweep within the retention window = %d, %v; want 0 and the read error", removed, err)
+	}
+	if _, err := os.Stat(filepath.Join(st.dir, id)); err != nil {
+		t.Fatalf("unreadable entry deleted before the retention window ended: stat err = %v", err)
+	}
+
+	st.now = func() time.Time { return time.Now().Add(25 * time.Hour) }
+	if removed, _ := st.Sweep(); removed != 1 {
+		t.Errorf("Sweep removed %d entries, want 1", removed)
+	}
+	if _, err := os.Stat(filepath.Join(st.dir, id)); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("unreadable entry outlived the retention window: stat err = %v", err)
+	}
+	if removed, err := st.Sweep(); removed != 0 || err != nil {
+		t.Errorf("Sweep of an empty store = %d, %v; want 0, nil", removed, err)
+	}
+}
+
+func TestRejectsIDsOutsideTheStore(t *testing.T) {
+	st := newTestStore(t)
+	if _, err := st.Load("../../etc/passwd"); err == nil {
+		t.Error("Load accepted a path as a user ID")
+	}
+	if err := st.Forget("../secret"); err == nil {
+		t.Error("Forget accepted a path as a user ID")
+	}
+}
+
+func TestSaveSeveralLabelledAddresses(t *testing.T) {
+	st := newTestStore(t)
+	id := st.UserID(newTestKey(t))
+	campus := address.InputAddress{Street: "400 Hull St", City: "Richmond", State: "VA"}
+	moved := address.InputAddress{Street: "1 Capitol Sq", City: "Richmond", State: "VA"}
+
+	for _, saved := range []SavedAddress{{"home", testAddress}, {"campus", campus}, {"Home", moved}} {
+		if _, err := st.Save(id, saved.Label, saved.Address); err != nil {
+			t.Fatalf("Save(%q): %v", saved.Label, err)
+		}
+	}
+	entry, err := st.Load(id)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	// Labels match case-insensitively, so "Home" replaced "home"
+	want := []SavedAddress{{"home", moved}, {"campus", campus}}
+	if !slices.Equal(entry.Addresses, want) {
+		t.Errorf("Addresses = %+v, want %+v", entry.Addresses, want)
+	}
+
+	if entry, err = st.Remove(id, "home"); err != nil {
+		t.Fatalf("Remove: %v", err)
+	}
+	if want := []SavedAddress{{"campus", campus}}; !slices.Equal(entry.Addresses, want) {
+		t.Errorf("after Remove: Addresses = %+v, want %+v", entry.Addresses, want)
+	}
+	if _, err := st.Remove(id, "campus"); err != nil {
+		t.Fatalf("Remove last: %v", err)
+	}
+	if _, err := st.Load(id); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Load after removing every address: err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestLoadMigratesSingleAddressEntries(t *testing.T) {
+	st := newTestStore(t)
+	id := st.UserID(newTestKey(t))
+	sealed, err := st.seal(id, []byte(`{"address":{"Street":"1234 W Broad St","City":"Richmond","State":"VA","PostalCode":"23220"},"savedAt":"`+time.Now().Format(time.RFC3339)+`"}`))
+	if err != nil {
+		t.Fatalf("seal: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(st.dir, id), sealed, 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	entry, err := st.Load(id)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if want := []SavedAddress{{Label: "home", Address: testAddress}}; !slices.Equal(entry.Addresses, want) {
+		t.Errorf("Addresses = %+v, want %+v", entry.Addresses, want)
+	}
+}
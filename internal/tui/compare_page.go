@@ -0,0 +1,188 @@
+package tui
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/govote-sh/govote/internal/domain"
+)
+
+// minCompareColumn is the narrowest a candidate's column gets on the
+// compare page. When the marked candidates don't all fit at this width,
+// the page scrolls sideways.
+const minCompareColumn = 22
+
+// selectedContest is the contest selected in the contests list.
+func (m model) selectedContest() (domain.Contest, bool) {
+	if m.contestsList == nil {
+		return domain.Contest{}, false
+	}
+	item, ok := m.contestsList.SelectedItem().(contestItem)
+	return item.Contest, ok
+}
+
+// markedCandidates are the candidates marked for comparison, in ballot
+// listing order.
+func (m model) markedCandidates() []domain.Candidate {
+	contest, ok := m.selectedContest()
+	if !ok {
+		return nil
+	}
+	var marked []domain.Candidate
+	for i, c := range contest.Candidates {
+		if m.compareMarks[i] {
+			marked = append(marked, c)
+		}
+	}
+	return marked
+}
+
+// toggleCompareMark marks or unmarks the candidate under the cursor.
+func (m model) toggleCompareMark() model {
+	marks := map[int]bool{}
+	for i, marked := range m.compareMarks {
+		marks[i] = marked
+	}
+	marks[m.candidateCursor] = !marks[m.candidateCursor]
+	m.compareMarks = marks
+	return m
+}
+
+// compareColumns is how many candidates fit side by side, and how wide
+// each of their columns is.
+func (m model) compareColumns(candidates int) (count, width int) {
+	const gap = 2
+	available := m.width - 2
+	count = max(1, min(candidates, (available+gap)/(minCompareColumn+gap)))
+	return count, (available - gap*(count-1)) / count
+}
+
+// compareFields are the fields compared, by label. Each social media
+// channel is a field too, between contact details and ballot order.
+func compareFields(c domain.Candidate) map[string]string {
+	fields := map[string]string{
+		"Party":   c.Party,
+		"Website": c.URL,
+		"Email":   c.Email,
+		"Phone":   c.Phone,
+	}
+	for _, channel := range c.Channels {
+		fields[channel.Type] = channel.ID
+	}
+	if c.OrderOnBallot > 0 {
+		fields["Ballot Order"] = strconv.Itoa(c.OrderOnBallot)
+	}
+	return fields
+}
+
+func (m model) updateCompare(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok {
+		count, _ := m.compareColumns(len(m.markedCandidates()))
+		switch {
+		case key.Matches(keyMsg, m.keys.Back):
+			m.currPage = m.parentPage()
+		case key.Matches(keyMsg, m.keys.CompareLeft):
+			m.compareOffset = max(0, m.compareOffset-1)
+		case key.Matches(keyMsg, m.keys.CompareRight):
+			m.compareOffset = max(0, min(len(m.markedCandidates())-count, m.compareOffset+1))
+		}
+	}
+	return m, nil
+}
+
+func (m model) viewCompare() string {
+	candidates := m.markedCandidates()
+	if len(candidates) < 2 {
+		return m.renderPageError(m.t("Mark two or more candidates to compare them"))
+	}
+	contest, _ := m.selectedContest()
+
+	count, width := m.compareColumns(len(candidates))
+	if m.plain {
+		// Plain mode lists them one after another, so they all fit
+		count = len(candidates)
+	}
+	offset := max(0, min(m.compareOffset, len(candidates)-count))
+	shown := candidates[offset : offset+count]
+
+	// Columns are two apart
+	cell := func(i int) lipgloss.Style {
+		if i == len(shown)-1 {
+			return lipgloss.NewStyle().Width(width)
+		}
+		return lipgloss.NewStyle().Width(width).MarginRight(2)
+	}
+	labelStyle := lipgloss.NewStyle().Foreground(m.theme.Muted).Render
+	emptyStyle := lipgloss.NewStyle().Foreground(m.theme.Faint).Render
+
+	// Line the fields up across candidates, one row per field any of them
+	// has, so each row is as tall as its longest value
+	values := make([]map[string]string, len(shown))
+	var channels []string
+	for i, c := range shown {
+		values[i] = compareFields(c)
+		for _, channel := range c.Channels {
+			if !slices.Contains(channels, channel.Type) {
+				channels = append(channels, channel.Type)
+			}
+		}
+	}
+	var labels []string
+	for _, label := range slices.Concat([]string{"Party", "Website", "Email", "Phone"}, channels, []string{"Ballot Order"}) {
+		for i := range shown {
+			if values[i][label] != "" {
+				labels = append(labels, label)
+				break
+			}
+		}
+	}
+
+	if m.plain {
+		blocks := make([]string, 0, len(shown))
+		for i, c := range shown {
+			block := []string{m.theme.sectionTitle(c.Name)}
+			for _, label := range labels {
+				if v := values[i][label]; v != "" {
+					block = append(block, m.t(label)+": "+m.theme.fieldValue(v))
+				}
+			}
+			blocks = append(blocks, strings.Join(block, "\n"))
+		}
+		return lipgloss.NewStyle().Margin(1, 1).MaxWidth(m.width).MaxHeight(m.height).Render(joinNonEmptyVertical(
+			lipgloss.Top,
+			m.HeaderView(),
+			m.theme.sectionTitle(m.tf("Comparing candidates for %s", contestName(contest))),
+			lipgloss.NewStyle().MarginTop(1).Render(strings.Join(blocks, "\n\n")),
+			m.helpFooter(),
+		))
+	}
+
+	names := make([]string, len(shown))
+	for i, c := range shown {
+		names[i] = cell(i).Render(m.theme.sectionTitle(c.Name))
+	}
+	rows := []string{lipgloss.JoinHorizontal(lipgloss.Top, names...)}
+	for _, label := range labels {
+		cells := make([]string, len(shown))
+		for i := range shown {
+			value := emptyStyle("—")
+			if v := values[i][label]; v != "" {
+				value = m.theme.fieldValue(v)
+			}
+			cells[i] = cell(i).Render(labelStyle(m.t(label)) + "\n" + value)
+		}
+		rows = append(rows, "", lipgloss.JoinHorizontal(lipgloss.Top, cells...))
+	}
+
+	return lipgloss.NewStyle().Margin(1, 1).MaxWidth(m.width).MaxHeight(m.height).Render(joinNonEmptyVertical(
+		lipgloss.Top,
+		m.HeaderView(),
+		m.theme.sectionTitle(m.tf("Comparing candidates for %s", contestName(contest))),
+		lipgloss.NewStyle().MarginTop(1).Render(strings.Join(rows, "\n")),
+		m.helpFooter(),
+	))
+}
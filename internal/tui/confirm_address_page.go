@@ -0,0 +1,98 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/govote-sh/govote/internal/address"
+	"github.com/govote-sh/govote/internal/domain"
+)
+
+// addressField pairs one field of the address the user entered with the
+// corresponding part of the address the API matched it to.
+type addressField struct {
+	label   string
+	entered string
+	matched string
+}
+
+// changed reports whether the API altered the field. Differences in case and
+// spacing don't count: "main st" matching "Main St" is not worth pointing out.
+func (f addressField) changed() bool {
+	return !strings.EqualFold(strings.Join(strings.Fields(f.entered), " "), strings.Join(strings.Fields(f.matched), " "))
+}
+
+// compareAddress lines up the entered address against the API's
+// NormalizedInput, field by field.
+func compareAddress(entered address.InputAddress, matched domain.Address) []addressField {
+	var street []string
+	for _, line := range []string{matched.Line1, matched.Line2, matched.Line3} {
+		if line != "" {
+			street = append(street, line)
+		}
+	}
+	return []addressField{
+		{"Street Address", entered.Street, strings.Join(street, ", ")},
+		{"City", entered.City, matched.City},
+		{"State", entered.State, matched.State},
+		{"Postal Code", entered.PostalCode, matched.Zip},
+	}
+}
+
+func (m model) updateConfirmAddress(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok {
+		switch {
+		case key.Matches(keyMsg, m.keys.Accept):
+			return m.acceptResults()
+		case key.Matches(keyMsg, m.keys.Reject):
+			// Back to the form with what the user typed, not a blank one
+			m.electionData = nil
+			m.form = m.newAddressForm(m.input)
+			m.currPage = inputPage
+			return m, m.form.Init()
+		case key.Matches(keyMsg, m.keys.Quit):
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+func (m model) viewConfirmAddress() string {
+	matched := m.electionData.NormalizedInput
+
+	changedStyle := lipgloss.NewStyle().Foreground(m.theme.Warning).Bold(true).Render
+	hintStyle := lipgloss.NewStyle().Foreground(m.theme.Muted).Render
+
+	var rows []string
+	for _, field := range compareAddress(m.input, matched) {
+		if field.matched == "" && field.entered == "" {
+			continue
+		}
+		matchedValue := m.theme.fieldValue(field.matched)
+		if field.changed() {
+			note := "  " + m.tf("(you entered %q)", field.entered)
+			if field.entered == "" {
+				note = "  " + m.t("(added)")
+			}
+			matchedValue = changedStyle(field.matched) + hintStyle(note)
+		}
+		rows = append(rows, fmt.Sprintf("%s: %s", m.theme.fieldLabel(m.t(field.label)), matchedValue))
+	}
+
+	return lipgloss.NewStyle().Margin(1, 1).MaxWidth(m.width).MaxHeight(m.height).Render(
+		lipgloss.JoinVertical(
+			lipgloss.Top,
+			m.theme.sectionTitle(m.t("Is this the right address?")),
+			"",
+			fmt.Sprintf("%s %s", m.theme.fieldLabel(m.t("We matched:")), m.theme.fieldValue(matched.String())),
+			"",
+			strings.Join(rows, "\n"),
+			"",
+			hintStyle(m.t("Highlighted fields were changed by the Voting Information Project.")),
+			m.helpFooter(),
+		),
+	)
+}
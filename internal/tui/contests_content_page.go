@@ -2,21 +2,42 @@ package tui
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
+	"charm.land/bubbles/v2/key"
 	"charm.land/bubbles/v2/table"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
-	"github.com/govote-sh/govote/internal/api"
-	"github.com/govote-sh/govote/internal/utils"
+	"github.com/govote-sh/govote/internal/domain"
+	"github.com/govote-sh/govote/internal/i18n"
 )
 
 func (m model) updateContestContent(msg tea.Msg) (tea.Model, tea.Cmd) {
 	if keyMsg, ok := msg.(tea.KeyPressMsg); ok {
-		switch keyMsg.String() {
-		case "esc":
-			m.currPage = contestsPage
+		contest, _ := m.selectedContest()
+		if key.Matches(keyMsg, m.keys.Back) {
+			m.currPage = m.parentPage()
 			return m, nil
 		}
+
+		// Candidates can be marked and compared in races with two or more
+		if len(contest.Candidates) < 2 {
+			return m, nil
+		}
+		switch {
+		case key.Matches(keyMsg, m.keys.CandidateUp):
+			m.candidateCursor = max(0, m.candidateCursor-1)
+		case key.Matches(keyMsg, m.keys.CandidateDown):
+			m.candidateCursor = min(len(contest.Candidates)-1, m.candidateCursor+1)
+		case key.Matches(keyMsg, m.keys.Mark):
+			m = m.toggleCompareMark()
+		case key.Matches(keyMsg, m.keys.Compare):
+			if len(m.markedCandidates()) >= 2 {
+				m.compareOffset = 0
+				m.currPage = comparePage
+			}
+		}
 	}
 	return m, nil
 }
@@ -24,73 +45,60 @@ func (m model) updateContestContent(msg tea.Msg) (tea.Model, tea.Cmd) {
 func (m model) viewContestContent() string {
 	// Check if contestsList is nil
 	if m.contestsList == nil {
-		return m.renderPageError("No contest selected")
+		return m.renderPageError(m.t("No contest selected"))
 	}
 
 	// Check if selected item exists
 	selectedItem := m.contestsList.SelectedItem()
 	if selectedItem == nil {
-		return m.renderPageError("No contest selected")
+		return m.renderPageError(m.t("No contest selected"))
 	}
 
 	// Type assert with safety check
-	selectedContest, ok := selectedItem.(api.Contest)
+	item, ok := selectedItem.(contestItem)
 	if !ok {
-		return m.renderPageError("Invalid contest data")
+		return m.renderPageError(m.t("Invalid contest data"))
 	}
+	selectedContest := item.Contest
 
 	// Title styling
-	title := sectionTitleStyle("Contest Details")
+	title := m.theme.sectionTitle(m.t("Contest Details"))
 
 	// Contest basic info
 	var basicInfo []string
 	if selectedContest.BallotTitle != "" {
-		basicInfo = append(basicInfo, fmt.Sprintf("%s: %s", fieldLabelStyle("Ballot Title"), fieldValueStyle(selectedContest.BallotTitle)))
+		basicInfo = append(basicInfo, fmt.Sprintf("%s: %s", m.theme.fieldLabel(m.t("Ballot Title")), m.theme.fieldValue(selectedContest.BallotTitle)))
 	}
 	if selectedContest.Office != "" {
-		basicInfo = append(basicInfo, fmt.Sprintf("%s: %s", fieldLabelStyle("Office"), fieldValueStyle(selectedContest.Office)))
+		basicInfo = append(basicInfo, fmt.Sprintf("%s: %s", m.theme.fieldLabel(m.t("Office")), m.theme.fieldValue(selectedContest.Office)))
+	}
+	if selectedContest.PrimaryParty != "" {
+		basicInfo = append(basicInfo, fmt.Sprintf("%s: %s", m.theme.fieldLabel(m.t("Primary")), m.theme.fieldValue(m.tf("%s voters choose their nominee", selectedContest.PrimaryParty))))
+	}
+	if selectedContest.Special {
+		basicInfo = append(basicInfo, lipgloss.NewStyle().Bold(true).Foreground(m.theme.Warning).Render(m.t("Special election: this seat is being filled outside the regular schedule")))
 	}
-	if selectedContest.NumberElected != "" {
-		basicInfo = append(basicInfo, fmt.Sprintf("%s: %s", fieldLabelStyle("Number Elected"), fieldValueStyle(selectedContest.NumberElected)))
+	if selectedContest.NumberElected > 0 {
+		basicInfo = append(basicInfo, fmt.Sprintf("%s: %s", m.theme.fieldLabel(m.t("Number Elected")), m.theme.fieldValue(strconv.Itoa(selectedContest.NumberElected))))
 	}
-	if selectedContest.BallotPlacement != "" {
-		basicInfo = append(basicInfo, fmt.Sprintf("%s: %s", fieldLabelStyle("Ballot Placement"), fieldValueStyle(selectedContest.BallotPlacement)))
+	if selectedContest.BallotPlacement > 0 {
+		basicInfo = append(basicInfo, fmt.Sprintf("%s: %s", m.theme.fieldLabel(m.t("Ballot Placement")), m.theme.fieldValue(strconv.Itoa(selectedContest.BallotPlacement))))
 	}
 
 	// Electorate Specifications
 	var electorateSpecs string
 	if selectedContest.ElectorateSpecifications != "" {
-		electorateSpecs = fmt.Sprintf("%s: %s", fieldLabelStyle("Electorate Specifications"), fieldValueStyle(selectedContest.ElectorateSpecifications))
-	}
-
-	// Referendum Information for ballot-measure contests
-	var referendumInfo []string
-	if selectedContest.ReferendumTitle != "" {
-		referendumInfo = append(referendumInfo, fmt.Sprintf("%s: %s", fieldLabelStyle("Referendum Title"), fieldValueStyle(selectedContest.ReferendumTitle)))
-	}
-	if selectedContest.ReferendumText != "" {
-		referendumInfo = append(referendumInfo, fmt.Sprintf("%s:\n%s", fieldLabelStyle("Referendum Text"), fieldValueStyle(utils.Wrap(selectedContest.ReferendumText, m.width-4))))
-	}
-	if selectedContest.ReferendumSubtitle != "" {
-		referendumInfo = append(referendumInfo, fmt.Sprintf("%s: %s", fieldLabelStyle("Subtitle"), fieldValueStyle(selectedContest.ReferendumSubtitle)))
-	}
-	if selectedContest.ReferendumBrief != "" {
-		referendumInfo = append(referendumInfo, fmt.Sprintf("%s: %s", fieldLabelStyle("Description"), fieldValueStyle(selectedContest.ReferendumBrief)))
-	}
-	if selectedContest.ReferendumProStatement != "" {
-		referendumInfo = append(referendumInfo, fmt.Sprintf("%s: %s", fieldLabelStyle("Pro Statement"), fieldValueStyle(selectedContest.ReferendumProStatement)))
-	}
-	if selectedContest.ReferendumConStatement != "" {
-		referendumInfo = append(referendumInfo, fmt.Sprintf("%s: %s", fieldLabelStyle("Con Statement"), fieldValueStyle(selectedContest.ReferendumConStatement)))
-	}
-	if selectedContest.ReferendumUrl != "" {
-		referendumInfo = append(referendumInfo, fmt.Sprintf("%s: %s", fieldLabelStyle("URL"), fieldValueStyle(selectedContest.ReferendumUrl)))
+		electorateSpecs = fmt.Sprintf("%s: %s", m.theme.fieldLabel(m.t("Electorate Specifications")), m.theme.fieldValue(selectedContest.ElectorateSpecifications))
 	}
 
 	// Candidate Information for office contests
 	var candidateTable string
 	if len(selectedContest.Candidates) > 0 {
-		candidateTable = sectionTitleStyle("Candidates") + "\n" + newCandidateTable(selectedContest.Candidates).View()
+		candidates := newCandidateTable(selectedContest.Candidates, m.candidateCursor, m.compareMarks, m.locale, m.theme).View()
+		if m.plain {
+			candidates = plainCandidates(selectedContest.Candidates, m.candidateCursor, m.compareMarks, m.locale)
+		}
+		candidateTable = m.theme.sectionTitle(m.t("Candidates")) + "\n" + candidates
 	}
 
 	return lipgloss.NewStyle().Margin(1, 1).MaxWidth(m.width).MaxHeight(m.height).Render(
@@ -100,36 +108,78 @@ func (m model) viewContestContent() string {
 			title,
 			joinNonEmptyVertical(lipgloss.Top, basicInfo...),
 			electorateSpecs,
-			joinNonEmptyVertical(lipgloss.Top, referendumInfo...),
 			candidateTable,
+			m.helpFooter(),
 		),
 	)
 }
 
-// Helper function to create a candidate table
-func newCandidateTable(candidates []api.Candidate) table.Model {
+// Helper function to create a candidate table. In races with two or more
+// candidates, the first column shows the cursor and which candidates are
+// marked for comparison.
+func newCandidateTable(candidates []domain.Candidate, cursor int, marked map[int]bool, loc i18n.Locale, theme Theme) table.Model {
+	comparable := len(candidates) >= 2
 	columns := []table.Column{
-		{Title: "Name", Width: 45},
-		{Title: "Party", Width: 20},
+		{Title: loc.T("Name"), Width: 45},
+		{Title: loc.T("Party"), Width: 20},
+	}
+	if comparable {
+		columns = append([]table.Column{{Title: "", Width: 4}}, columns...)
 	}
 	var rows []table.Row
-	for _, candidate := range candidates {
-		rows = append(rows, table.Row{
+	for i, candidate := range candidates {
+		row := table.Row{
 			candidate.Name,
 			candidate.Party,
-		})
+		}
+		if comparable {
+			mark := "  "
+			if i == cursor {
+				mark = "› "
+			}
+			if marked[i] {
+				mark += "✓"
+			}
+			row = append(table.Row{mark}, row...)
+		}
+		rows = append(rows, row)
 	}
 	// The table's internal viewport defaults to width 0 and renders rows as
 	// empty strings until an explicit width is set; the styles below have no
 	// cell padding, so the row width is exactly the sum of the column widths.
 	t := table.New(table.WithColumns(columns), table.WithRows(rows), table.WithHeight(10), table.WithWidth(sumColumnWidths(columns)))
 	t.SetStyles(table.Styles{
-		Header: lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("205")),
-		Cell:   lipgloss.NewStyle().Foreground(lipgloss.Color("255")),
+		Header: lipgloss.NewStyle().Bold(true).Foreground(theme.Accent),
+		Cell:   lipgloss.NewStyle().Foreground(theme.Text),
 	})
+	t.SetCursor(cursor) // Scrolls long lists to the cursor
 	return t
 }
 
+// plainCandidates lists the candidates a line each, for plain mode. Like
+// the table, it shows the cursor and the candidates marked for comparison
+// in races with two or more.
+func plainCandidates(candidates []domain.Candidate, cursor int, marked map[int]bool, loc i18n.Locale) string {
+	comparable := len(candidates) >= 2
+	lines := make([]string, 0, len(candidates))
+	for i, candidate := range candidates {
+		line := fmt.Sprintf("%d. %s", i+1, candidate.Name)
+		if candidate.Party != "" {
+			line += ", " + candidate.Party
+		}
+		if comparable && marked[i] {
+			line += " " + loc.T("(marked)")
+		}
+		if comparable && i == cursor {
+			line = "> " + line
+		} else {
+			line = "  " + line
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
 // sumColumnWidths returns the total rendered row width for a table whose
 // styles add no horizontal padding, which is how all tables here are styled.
 func sumColumnWidths(columns []table.Column) int {
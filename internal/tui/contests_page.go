@@ -1,43 +1,310 @@
 package tui
 
 import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
 	"charm.land/bubbles/v2/list"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
-	"github.com/govote-sh/govote/internal/api"
+	"github.com/govote-sh/govote/internal/domain"
+	"github.com/govote-sh/govote/internal/i18n"
 )
 
-func (m model) InitContestsList() *list.Model {
+// contestItem is a contest in the contests list.
+type contestItem struct {
+	domain.Contest
+	index  int // Index into electionData.Contests
+	locale i18n.Locale
+}
+
+// Title falls back to the measure's own title, since ballot measures often
+// have no ballot title, and marks special elections.
+func (c contestItem) Title() string {
+	title := c.Contest.Title()
+	if c.BallotTitle == "" {
+		title = contestName(c.Contest)
+	}
+	if c.Special {
+		title += " " + c.locale.T("(Special Election)")
+	}
+	return title
+}
+
+func (c contestItem) Description() string {
+	description := c.Contest.Description()
+	if c.Office == "" && c.Referendum != nil {
+		description = c.Referendum.Subtitle
+	}
+	if c.PrimaryParty != "" {
+		description = joinDescription(c.locale.Tf("%s primary", c.PrimaryParty), description)
+	}
+	return description
+}
+
+// joinDescription joins the non-empty parts of a list item's description.
+func joinDescription(parts ...string) string {
+	var nonEmpty []string
+	for _, part := range parts {
+		if part != "" {
+			nonEmpty = append(nonEmpty, part)
+		}
+	}
+	return strings.Join(nonEmpty, " · ")
+}
+
+// contestSection heads a group of contests: every race for one level of
+// government and district, or every ballot measure.
+type contestSection struct {
+	key       string // Identifies the section across rebuilds
+	title     string // e.g. "County · Henrico County"
+	count     int
+	collapsed bool
+	locale    i18n.Locale
+}
+
+func (s contestSection) FilterValue() string { return s.title }
+
+func (s contestSection) Title() string {
+	if s.collapsed {
+		return "▸ " + s.title
+	}
+	return "▾ " + s.title
+}
+
+// plainTitle says in words what the arrow shows.
+func (s contestSection) plainTitle() string {
+	if s.collapsed {
+		return s.title + " " + s.locale.T("(folded)")
+	}
+	return s.title
+}
+
+func (s contestSection) Description() string {
+	format := "%d contests"
+	switch {
+	case s.key == measuresKey && s.count == 1:
+		format = "%d measure"
+	case s.key == measuresKey:
+		format = "%d measures"
+	case s.count == 1:
+		format = "%d contest"
+	}
+	return "  " + s.locale.Tf(format, s.count)
+}
+
+// contestGroup is a section and the contests in it, as indexes into
+// electionData.Contests.
+type contestGroup struct {
+	section  contestSection
+	rank     int // Sort key: the level, widest first
+	contests []int
+}
+
+// measuresKey is the section key for ballot measures, which are grouped
+// together whatever their level.
+const measuresKey = "measures"
+
+// contestGroups groups the contests by level, widest first, then by
+// district in ballot order. Ballot measures come last.
+func (m model) contestGroups() []contestGroup {
+	// Contests without a level come after every level, and measures last
+	unknownRank, measuresRank := int(domain.LevelSpecial)+1, int(domain.LevelSpecial)+2
+
+	var groups []contestGroup
+	index := map[string]int{} // Section key to index in groups
+	for i, c := range m.electionData.Contests {
+		if !m.onPartyBallot(c) {
+			continue
+		}
+		key, title, rank := measuresKey, m.t("Ballot Measures"), measuresRank
+		if c.Referendum == nil {
+			level := domain.LevelUnknown
+			if len(c.Levels) > 0 {
+				level = c.Levels[0]
+			}
+			key = fmt.Sprintf("%d/%s", level, c.District.Name)
+			title = m.t(level.String())
+			if c.District.Name != "" {
+				title += " · " + c.District.Name
+			}
+			rank = int(level)
+			if level == domain.LevelUnknown {
+				rank = unknownRank
+			}
+		}
+
+		g, ok := index[key]
+		if !ok {
+			g = len(groups)
+			index[key] = g
+			groups = append(groups, contestGroup{
+				section: contestSection{key: key, title: title, collapsed: m.collapsedSections[key], locale: m.locale},
+				rank:    rank,
+			})
+		}
+		groups[g].contests = append(groups[g].contests, i)
+		groups[g].section.count++
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool { return groups[i].rank < groups[j].rank })
+	return groups
+}
+
+// contestItems lists each section followed by its contests, unless it's
+// collapsed.
+func (m model) contestItems() []list.Item {
 	items := []list.Item{}
-	for _, contest := range m.electionData.Contests {
-		items = append(items, list.Item(contest))
+	for _, g := range m.contestGroups() {
+		items = append(items, g.section)
+		if g.section.collapsed {
+			continue
+		}
+		for _, i := range g.contests {
+			items = append(items, contestItem{Contest: m.electionData.Contests[i], index: i, locale: m.locale})
+		}
+	}
+	return items
+}
+
+// primaryParties are the parties holding primaries in this election, in
+// ballot order. It's empty unless this is a primary.
+func (m model) primaryParties() []string {
+	var parties []string
+	for _, c := range m.electionData.Contests {
+		if c.PrimaryParty != "" && !slices.ContainsFunc(parties, func(p string) bool { return strings.EqualFold(p, c.PrimaryParty) }) {
+			parties = append(parties, c.PrimaryParty)
+		}
+	}
+	return parties
+}
+
+// onPartyBallot reports whether c is on the chosen party's primary ballot:
+// that party's contests and every nonpartisan one. With no party chosen,
+// every contest is.
+func (m model) onPartyBallot(c domain.Contest) bool {
+	return m.primaryParty == "" || c.PrimaryParty == "" || strings.EqualFold(c.PrimaryParty, m.primaryParty)
+}
+
+// nextPartyBallot switches to the next party's primary ballot, going back
+// to all parties after the last one.
+func (m model) nextPartyBallot() model {
+	parties := m.primaryParties()
+	next := 0
+	if i := slices.Index(parties, m.primaryParty); i >= 0 {
+		next = i + 1
+	}
+	m.primaryParty = ""
+	if next < len(parties) {
+		m.primaryParty = parties[next]
+	}
+	m.contestsList.SetItems(m.contestItems())
+	if len(m.contestsList.Items()) > 1 {
+		m.contestsList.Select(1)
+	}
+	return m
+}
+
+// partyBallotLine says which party's primary ballot is shown, for primaries.
+func (m model) partyBallotLine() string {
+	if len(m.primaryParties()) == 0 {
+		return ""
+	}
+	keyStyle := lipgloss.NewStyle().Foreground(m.theme.Accent).Render
+	hintStyle := lipgloss.NewStyle().Foreground(m.theme.Muted).Render
+	ballot := m.t("Every party's primary contests")
+	if m.primaryParty != "" {
+		ballot = m.tf("%s ballot: %s primary and nonpartisan contests", m.primaryParty, m.primaryParty)
+	}
+	return lipgloss.NewStyle().MarginLeft(2).Render(
+		m.theme.fieldValue(ballot) + hintStyle(" · ") + keyStyle(keyLabel(m.keys.PartyBallot)) + hintStyle(" "+m.t("Choose party ballot")))
+}
+
+// contestsListHeight leaves room for the header, any notice banner, the
+// party ballot line and the help footer.
+func (m model) contestsListHeight() int {
+	height := m.listHeight()
+	if line := m.partyBallotLine(); line != "" {
+		height -= lipgloss.Height(line)
+	}
+	return height
+}
+
+func (m model) InitContestsList() *list.Model {
+	model := list.New(m.contestItems(), m.newContestDelegate(), m.listWidth(), m.contestsListHeight())
+	model.Title = m.t("Contests")
+	m.searchable(&model)
+	m.plainList(&model, m.newContestDelegate())
+	// Each section counts its own contests
+	model.SetShowStatusBar(false)
+	// Start on the first contest rather than its section's header
+	if len(model.Items()) > 1 {
+		model.Select(1)
 	}
-	model := list.New(items, list.NewDefaultDelegate(), m.width, m.height-4)
 	return &model
 }
 
-func (m model) updateContests(msg tea.Msg) (tea.Model, tea.Cmd) {
-	if m.contestsList != nil {
-		var cmd tea.Cmd
-		contestsList, cmd := m.contestsList.Update(msg)
-		m.contestsList = &contestsList
-		if cmd != nil {
-			return m, cmd
+// selectContest selects the contest at index i of electionData.Contests,
+// expanding its section if it's collapsed and showing every party's
+// contests if it's on another party's ballot.
+func (m model) selectContest(i int) model {
+	if !m.onPartyBallot(m.electionData.Contests[i]) {
+		m.primaryParty = ""
+	}
+	for _, g := range m.contestGroups() {
+		for _, c := range g.contests {
+			if c == i && g.section.collapsed {
+				m.collapsedSections[g.section.key] = false
+			}
+		}
+	}
+	m.contestsList.SetItems(m.contestItems())
+	for n, item := range m.contestsList.Items() {
+		if c, ok := item.(contestItem); ok && c.index == i {
+			m.contestsList.Select(n)
+		}
+	}
+	return m
+}
+
+// toggleSection collapses or expands a section, keeping it selected.
+func (m model) toggleSection(s contestSection) model {
+	m.collapsedSections[s.key] = !s.collapsed
+	m.contestsList.SetItems(m.contestItems())
+	for n, item := range m.contestsList.Items() {
+		if section, ok := item.(contestSection); ok && section.key == s.key {
+			m.contestsList.Select(n)
 		}
 	}
+	return m
+}
 
-	if keyMsg, ok := msg.(tea.KeyPressMsg); ok {
-		switch keyMsg.String() {
-		case "q", "ctrl+c":
-			return m, tea.Quit
-		case "enter":
-			_, ok := m.contestsList.SelectedItem().(api.Contest)
-			if ok {
-				m.currPage = contestContentPage
+func (m model) updateContests(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok && m.contestsList != nil {
+		switch {
+		case key.Matches(keyMsg, m.keys.PartyBallot):
+			if len(m.primaryParties()) > 0 {
+				return m.nextPartyBallot(), nil
+			}
+			return m, nil
+		case key.Matches(keyMsg, m.keys.Details):
+			return m.openSelected()
+		case key.Matches(keyMsg, m.keys.Fold):
+			if section, ok := m.contestsList.SelectedItem().(contestSection); ok {
+				return m.toggleSection(section), nil
 			}
 			return m, nil
 		}
 	}
+
+	if m.contestsList != nil {
+		contestsList, cmd := m.contestsList.Update(msg)
+		m.contestsList = &contestsList
+		return m, cmd
+	}
 	return m, nil
 }
 
@@ -47,13 +314,72 @@ func (m model) viewContests() string {
 			lipgloss.JoinVertical(
 				lipgloss.Top,
 				m.HeaderView(),
-				m.RenderErrorBox("No contests available..."),
+				m.RenderErrorBox(m.t("No contests available...")),
 			),
 		)
 	}
-	return lipgloss.NewStyle().Margin(1, 1).MaxWidth(m.width).MaxHeight(m.height).Render(lipgloss.JoinVertical(
+	return lipgloss.NewStyle().Margin(1, 1).MaxWidth(m.width).MaxHeight(m.height).Render(joinNonEmptyVertical(
 		lipgloss.Top,
-		m.HeaderView(),
+		m.contestsHeading(),
 		m.contestsList.View(),
+		m.helpFooter(),
 	))
 }
+
+// contestsHeading is what the contests page draws above its list.
+func (m model) contestsHeading() string {
+	return joinNonEmptyVertical(lipgloss.Top, m.HeaderView(), m.partyBallotLine())
+}
+
+// newContestDelegate renders section headers in bold and ballot measures
+// in their own colors, so they stand out from races between candidates.
+// Under each contest go its office and district, and how many candidates
+// are running.
+func (m model) newContestDelegate() detailDelegate {
+	d := newDetailDelegate(3, m.theme, func(item list.Item) []string {
+		switch item := item.(type) {
+		case contestSection:
+			return []string{item.Description()}
+		case contestItem:
+			details := []string{item.Description()}
+			if item.Referendum != nil {
+				return details
+			}
+			var candidates string
+			if n := len(item.Candidates); n == 1 {
+				candidates = m.t("1 candidate")
+			} else if n > 1 {
+				candidates = m.tf("%d candidates", n)
+			}
+			return append(details, joinDescription(item.District.Name, candidates))
+		}
+		return nil
+	})
+
+	section := m.theme.itemStyles()
+	pink, grey := m.theme.Accent, m.theme.Muted
+	section.NormalTitle = section.NormalTitle.Foreground(pink).Bold(true)
+	section.NormalDesc = section.NormalDesc.Foreground(grey)
+	section.SelectedTitle = section.SelectedTitle.Foreground(pink).BorderForeground(pink).Bold(true)
+	section.SelectedDesc = section.SelectedDesc.Foreground(grey).BorderForeground(pink)
+
+	referendum := m.theme.itemStyles()
+	amber, tan := m.theme.Measure, m.theme.MeasureDetail
+	referendum.NormalTitle = referendum.NormalTitle.Foreground(amber)
+	referendum.NormalDesc = referendum.NormalDesc.Foreground(tan)
+	referendum.SelectedTitle = referendum.SelectedTitle.Foreground(amber).BorderForeground(amber)
+	referendum.SelectedDesc = referendum.SelectedDesc.Foreground(tan).BorderForeground(amber)
+
+	d.styles = func(item list.Item) *list.DefaultItemStyles {
+		switch item := item.(type) {
+		case contestSection:
+			return &section
+		case contestItem:
+			if item.Referendum != nil {
+				return &referendum
+			}
+		}
+		return nil
+	}
+	return d
+}
@@ -0,0 +1,57 @@
+package tui
+
+import (
+	"time"
+
+	"github.com/govote-sh/govote/internal/i18n"
+)
+
+// Dates from the API are calendar days, stored as midnight UTC. They are
+// compared against the server's local calendar day, not an instant, so
+// "today" doesn't flip over at midnight UTC.
+
+// formatDate renders a calendar day like "Tuesday, November 5, 2024".
+func formatDate(day time.Time, loc i18n.Locale) string {
+	return loc.Date(day)
+}
+
+// daysUntil counts calendar days from now's date to day; negative if day
+// has passed.
+func daysUntil(day, now time.Time) int {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return int(day.Sub(today).Hours() / 24)
+}
+
+// relativeDay describes day relative to now: "today", "in 12 days",
+// "3 days ago".
+func relativeDay(day, now time.Time, loc i18n.Locale) string {
+	switch n := daysUntil(day, now); {
+	case n == 0:
+		return loc.T("today")
+	case n == 1:
+		return loc.T("tomorrow")
+	case n == -1:
+		return loc.T("yesterday")
+	case n > 0:
+		return loc.Tf("in %d days", n)
+	default:
+		return loc.Tf("%d days ago", -n)
+	}
+}
+
+// relativeWindow describes a site's open window relative to now: "starts in
+// 2 days", "ends today", "ended 3 days ago". Either end may be zero.
+func relativeWindow(start, end, now time.Time, loc i18n.Locale) string {
+	switch {
+	case !start.IsZero() && daysUntil(start, now) > 0:
+		return loc.Tf("starts %s", relativeDay(start, now, loc))
+	case !end.IsZero() && daysUntil(end, now) < 0:
+		return loc.Tf("ended %s", relativeDay(end, now, loc))
+	case !end.IsZero():
+		return loc.Tf("open now, ends %s", relativeDay(end, now, loc))
+	case !start.IsZero():
+		return loc.T("open now")
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,115 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"charm.land/bubbles/v2/list"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/govote-sh/govote/internal/utils"
+)
+
+// maxTitleLines is how many lines a long title wraps onto before it's cut
+// short.
+const maxTitleLines = 2
+
+// detailDelegate renders list items to fit the list's width: titles wrap
+// onto a second line before being cut short, and under them go as many
+// lines of details as fit.
+type detailDelegate struct {
+	list.DefaultDelegate // Spacing, and the styles used unless styles says otherwise
+	height               int
+
+	// details are the lines shown under an item's title
+	details func(list.Item) []string
+	// styles picks an item's styles; nil for the default ones
+	styles func(list.Item) *list.DefaultItemStyles
+
+	// plain numbers the items and marks the selected one with an arrow,
+	// without styles, for plain mode
+	plain bool
+}
+
+func newDetailDelegate(height int, theme Theme, details func(list.Item) []string) detailDelegate {
+	d := detailDelegate{DefaultDelegate: theme.defaultDelegate(), height: height, details: details}
+	d.SetHeight(height)
+	return d
+}
+
+func (d detailDelegate) Height() int { return d.height }
+
+// wrapTitle wraps title to width, cutting it short after maxTitleLines.
+func wrapTitle(title string, width int) []string {
+	lines := strings.Split(utils.Wrap(title, width), "\n")
+	if len(lines) > maxTitleLines {
+		rest := strings.Join(lines[maxTitleLines-1:], " ")
+		lines = append(lines[:maxTitleLines-1], ansi.Truncate(rest, width-1, "")+"…")
+	}
+	for i, line := range lines {
+		// Words longer than the width don't wrap
+		lines[i] = ansi.Truncate(line, width, "…")
+	}
+	return lines
+}
+
+func (d detailDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	i, ok := item.(list.DefaultItem)
+	if !ok || m.Width() <= 0 {
+		return
+	}
+	s := &d.Styles
+	if d.styles != nil {
+		if styles := d.styles(item); styles != nil {
+			s = styles
+		}
+	}
+
+	titleStyle, detailStyle := s.NormalTitle, s.NormalDesc
+	if index == m.Index() {
+		titleStyle, detailStyle = s.SelectedTitle, s.SelectedDesc
+	}
+	title, prefix := i.Title(), ""
+	if d.plain {
+		titleStyle, detailStyle = lipgloss.NewStyle(), lipgloss.NewStyle()
+		if p, ok := item.(plainTitled); ok {
+			title = p.plainTitle()
+		}
+		prefix = fmt.Sprintf("  %d. ", index+1)
+		if index == m.Index() {
+			prefix = fmt.Sprintf("> %d. ", index+1)
+		}
+	}
+	// Wrapped lines and details line up under the title, after the prefix
+	indent := strings.Repeat(" ", len(prefix))
+
+	width := max(1, m.Width()-titleStyle.GetHorizontalFrameSize()-len(prefix))
+	titleLines := wrapTitle(title, width)
+	var detailLines []string
+	for _, line := range d.details(item) {
+		if line != "" {
+			detailLines = append(detailLines, ansi.Truncate(line, width, "…"))
+		}
+	}
+	// A long title crowds out the last details rather than the other way
+	// round
+	detailLines = detailLines[:min(len(detailLines), max(0, d.height-len(titleLines)))]
+
+	var lines []string
+	for n, line := range titleLines {
+		if n == 0 {
+			lines = append(lines, titleStyle.Render(prefix+line))
+		} else {
+			lines = append(lines, titleStyle.Render(indent+line))
+		}
+	}
+	for _, line := range detailLines {
+		lines = append(lines, detailStyle.Render(indent+line))
+	}
+	// Every item takes the same height, so the list can page through them
+	for len(lines) < d.height {
+		lines = append(lines, detailStyle.Render(""))
+	}
+	fmt.Fprint(w, strings.Join(lines, "\n")) //nolint: errcheck
+}
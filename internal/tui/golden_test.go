@@ -3,8 +3,13 @@ package tui
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/charmbracelet/x/exp/golden"
+	"github.com/govote-sh/govote/internal/address"
+	"github.com/govote-sh/govote/internal/api"
+	"github.com/govote-sh/govote/internal/domain"
+	"github.com/govote-sh/govote/internal/i18n"
 	"github.com/govote-sh/govote/internal/utils"
 )
 
@@ -24,6 +29,14 @@ func TestGoldenVotePage(t *testing.T) {
 	requireGoldenView(t, newVotePageModel(80, 24))
 }
 
+func TestGoldenVotePageSpanish(t *testing.T) {
+	m := newModel(80, 24).withLocale(i18n.Spanish)
+	m.now = fixtureNow
+	data, _ := domain.FromVoterInfo(fixtureVoterInfo())
+	m.electionData = &data
+	requireGoldenView(t, m.showResults())
+}
+
 func TestGoldenContestsPage(t *testing.T) {
 	m := newVotePageModel(80, 24)
 	m.currPage = contestsPage
@@ -48,9 +61,254 @@ func TestGoldenPollingPlacePage(t *testing.T) {
 	requireGoldenView(t, m)
 }
 
+func TestGoldenConfirmAddressPage(t *testing.T) {
+	m := newModel(80, 24)
+	data, _ := domain.FromVoterInfo(fixtureVoterInfo())
+	data.NormalizedInput = domain.Address{Line1: "1234 W Broad St", City: "Richmond", State: "VA", Zip: "23220"}
+	m.electionData = &data
+	m.input = address.InputAddress{Street: "1234 w broad st", City: "Richmnd", State: "VA"}
+	m.currPage = confirmAddressPage
+	requireGoldenView(t, m)
+}
+
 func TestGoldenErrorPage(t *testing.T) {
 	m := newModel(80, 24)
 	m.currPage = reinputConfirmationPage
 	m.err = &utils.ErrMsg{HTTPStatusCode: 400}
 	requireGoldenView(t, m)
 }
+
+func TestGoldenVotePageElectionOver(t *testing.T) {
+	m := newModel(80, 24)
+	m.now = func() time.Time { return fixtureNow().AddDate(0, 1, 0) }
+	raw := fixtureVoterInfo()
+	raw.OtherElections = []api.Election{{ID: "2001", Name: "Test Special Election", ElectionDay: "2027-02-16"}}
+	data, _ := domain.FromVoterInfo(raw)
+	m.electionData = &data
+	requireGoldenView(t, m.showResults())
+}
+
+func TestGoldenTimelinePage(t *testing.T) {
+	m := newModel(80, 24)
+	m.now = fixtureNow
+	raw := fixtureVoterInfo()
+	raw.EarlyVoteSites = []api.PollingPlace{
+		{Name: "Library", StartDate: "2026-10-10", EndDate: "2026-10-24"},
+		{Name: "City Hall", StartDate: "2026-10-17", EndDate: "2026-10-31"},
+	}
+	raw.DropOffLocations = []api.PollingPlace{{Name: "Courthouse", StartDate: "2026-10-01", EndDate: "2026-11-03"}}
+	data, _ := domain.FromVoterInfo(raw)
+	m.electionData = &data
+	m = m.showResults()
+	m.currPage = timelinePage
+	requireGoldenView(t, m)
+}
+
+func TestGoldenVotePageMailOnly(t *testing.T) {
+	m := newModel(80, 24)
+	m.now = fixtureNow
+	raw := fixtureVoterInfo()
+	raw.MailOnly = true
+	raw.PollingLocations = nil
+	raw.DropOffLocations = []api.PollingPlace{{Name: "Courthouse", Address: api.Address{Line1: "400 N 9th St", City: "Richmond", State: "VA"}}}
+	raw.State[0].ElectionAdministrationBody.AbsenteeVotingInfoUrl = "https://vote.example.gov/absentee"
+	data, _ := domain.FromVoterInfo(raw)
+	m.electionData = &data
+	requireGoldenView(t, m.showResults())
+}
+
+// withNotices is the fixture with an urgent notice from the state and one
+// from the local office.
+func withNotices() model {
+	m := newModel(80, 24)
+	m.now = fixtureNow
+	raw := fixtureVoterInfo()
+	raw.State[0].ElectionAdministrationBody.ElectionNoticeText = "Polling hours are extended to 9 PM statewide because of the storm."
+	raw.State[0].LocalJurisdiction = &api.AdministrationRegion{
+		Name: "Richmond City",
+		ElectionAdministrationBody: api.ElectionAdministrationBody{
+			ElectionNoticeText: "The Main Library polling place has moved to City Hall, 900 E Broad St, for this election only.",
+			ElectionNoticeUrl:  "https://vote.example.gov/richmond/notice",
+		},
+	}
+	data, _ := domain.FromVoterInfo(raw)
+	m.electionData = &data
+	return m.showResults()
+}
+
+func TestGoldenVotePageNotices(t *testing.T) {
+	requireGoldenView(t, withNotices())
+}
+
+func TestGoldenNoticePage(t *testing.T) {
+	requireGoldenView(t, withNotices().showNotices())
+}
+
+func TestGoldenSearchPage(t *testing.T) {
+	m, _ := newVotePageModel(80, 24).showSearch()
+	m = typeText(t, m, "main")
+	requireGoldenView(t, m)
+}
+
+// withLongBallot is the fixture with races at several levels and a ballot
+// measure, in the jumbled order the API sends them.
+func withLongBallot() model {
+	m := newModel(80, 40)
+	m.now = fixtureNow
+	raw := fixtureVoterInfo()
+	raw.Contests = []api.Contest{
+		{BallotTitle: "Mayor", Level: []string{"locality"}, District: api.District{Name: "Richmond City"}},
+		{ReferendumTitle: "Question 1", ReferendumSubtitle: "Library bonds"},
+		{BallotTitle: "Governor", Level: []string{"administrativeArea1"}, District: api.District{Name: "Virginia"}},
+		{BallotTitle: "U.S. Senate", Level: []string{"country"}, District: api.District{Name: "Virginia"}},
+		{BallotTitle: "City Council", Level: []string{"locality"}, District: api.District{Name: "Richmond City"}},
+	}
+	data, _ := domain.FromVoterInfo(raw)
+	m.electionData = &data
+	m = m.showResults()
+	m.currPage = contestsPage
+	return m
+}
+
+func TestGoldenContestsPageGrouped(t *testing.T) {
+	requireGoldenView(t, withLongBallot())
+}
+
+// A long ballot title wraps onto a second line and is cut short after it,
+// and the details under it are cut to the window
+func TestGoldenContestsPageNarrow(t *testing.T) {
+	m := newModel(40, 24)
+	m.now = fixtureNow
+	raw := fixtureVoterInfo()
+	raw.Contests = []api.Contest{{
+		BallotTitle: "Member, Board of Supervisors, Three Chopt Magisterial District, Unexpired Term",
+		Office:      "Member, Board of Supervisors",
+		Level:       []string{"administrativeArea2"},
+		District:    api.District{Name: "Three Chopt Magisterial District"},
+		Candidates:  []api.Candidate{{Name: "Jane Doe"}, {Name: "John Roe"}},
+	}}
+	data, _ := domain.FromVoterInfo(raw)
+	m.electionData = &data
+	m = m.showResults()
+	m.currPage = contestsPage
+	requireGoldenView(t, m)
+}
+
+// withReferendum is the fixture with a ballot measure, open on its page.
+func withReferendum(width, height int) model {
+	m := newModel(width, height)
+	m.now = fixtureNow
+	raw := fixtureVoterInfo()
+	raw.Contests = []api.Contest{{
+		ReferendumTitle:            "Question 1",
+		ReferendumSubtitle:         "Library bonds",
+		ReferendumBrief:            "Allows the city to borrow $20 million to build two branch libraries.",
+		ReferendumText:             "Shall the City of Richmond contract a debt and issue general obligation bonds in the maximum amount of $20,000,000 to build and equip two branch libraries?",
+		ReferendumProStatement:     "Two neighborhoods have no library within walking distance.",
+		ReferendumConStatement:     "The debt service would crowd out school maintenance for a decade.",
+		ReferendumPassageThreshold: "A simple majority",
+		ReferendumEffectOfAbstain:  "Not voting on the question does not count for or against it.",
+		ReferendumBallotResponses:  []string{"Yes", "No"},
+	}}
+	data, _ := domain.FromVoterInfo(raw)
+	m.electionData = &data
+	m = m.showResults()
+	m.currPage = contestsPage
+	return m.openContest()
+}
+
+func TestGoldenReferendumPage(t *testing.T) {
+	requireGoldenView(t, withReferendum(80, 40))
+}
+
+func TestGoldenReferendumPageNarrow(t *testing.T) {
+	requireGoldenView(t, withReferendum(60, 40))
+}
+
+// withPrimary is the fixture as a primary: each party's race for governor,
+// a nonpartisan school board race, and a special election.
+func withPrimary() model {
+	m := newModel(80, 40)
+	m.now = fixtureNow
+	raw := fixtureVoterInfo()
+	raw.Contests = []api.Contest{
+		{Type: "Primary", BallotTitle: "Governor", Office: "Governor", PrimaryParty: "Democratic", Level: []string{"administrativeArea1"}},
+		{Type: "Primary", BallotTitle: "Governor", Office: "Governor", PrimaryParty: "Republican", Level: []string{"administrativeArea1"}},
+		{BallotTitle: "School Board", Office: "School Board", Level: []string{"locality"}},
+		{Type: "Primary", BallotTitle: "House of Delegates", Office: "Delegate", PrimaryParty: "Democratic", Special: "Yes", Level: []string{"administrativeArea1"}},
+	}
+	data, _ := domain.FromVoterInfo(raw)
+	m.electionData = &data
+	m = m.showResults()
+	m.currPage = contestsPage
+	return m
+}
+
+func TestGoldenContestsPagePrimary(t *testing.T) {
+	requireGoldenView(t, withPrimary())
+}
+
+// withCandidates is the fixture's race for governor with three candidates,
+// the first and last marked for comparison.
+func withCandidates(width, height int) model {
+	m := newModel(width, height)
+	m.now = fixtureNow
+	raw := fixtureVoterInfo()
+	raw.Contests[0].Candidates = []api.Candidate{
+		{Name: "Alex Doe", Party: "Independent", CandidateUrl: "https://alexdoe.example.com", OrderOnBallot: 1,
+			Channels: []api.Channel{{Type: "Twitter", ID: "alexdoe"}}},
+		{Name: "Sam Roe", Party: "Democratic", Email: "sam@roe.example.com", OrderOnBallot: 2},
+		{Name: "Jordan Poe", Party: "Republican", Phone: "(804) 555-0100", OrderOnBallot: 3,
+			Channels: []api.Channel{{Type: "Facebook", ID: "jordanpoe"}}},
+	}
+	data, _ := domain.FromVoterInfo(raw)
+	m.electionData = &data
+	m = m.showResults()
+	m.currPage = contestsPage
+	m = m.openContest()
+	m.compareMarks = map[int]bool{0: true, 2: true}
+	return m
+}
+
+func TestGoldenContestDetailPageMarked(t *testing.T) {
+	requireGoldenView(t, withCandidates(80, 30))
+}
+
+func TestGoldenComparePage(t *testing.T) {
+	m := withCandidates(80, 30)
+	m.compareMarks[1] = true
+	m.currPage = comparePage
+	requireGoldenView(t, m)
+}
+
+func TestGoldenComparePageNarrow(t *testing.T) {
+	m := withCandidates(50, 30)
+	m.compareMarks[1] = true
+	m.currPage = comparePage
+	requireGoldenView(t, m)
+}
+
+func TestGoldenVotePagePlain(t *testing.T) {
+	m, _ := withNotices().togglePlain()
+	requireGoldenView(t, m)
+}
+
+func TestGoldenContestsPagePlain(t *testing.T) {
+	m, _ := withLongBallot().togglePlain()
+	requireGoldenView(t, m)
+}
+
+func TestGoldenContestDetailPagePlain(t *testing.T) {
+	m, _ := withCandidates(80, 30).togglePlain()
+	requireGoldenView(t, m)
+}
+
+func TestGoldenVotePageHighContrast(t *testing.T) {
+	m, _ := newVotePageModel(80, 24).setTheme("high-contrast")
+	requireGoldenView(t, m)
+}
+
+func TestGoldenReferendumPageColorblind(t *testing.T) {
+	m, _ := withReferendum(80, 40).setTheme("colorblind")
+	requireGoldenView(t, m)
+}
@@ -2,70 +2,137 @@ package tui
 
 import (
 	"fmt"
+	"slices"
 
+	"charm.land/bubbles/v2/key"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 	"charm.land/lipgloss/v2/table"
 )
 
 func (m model) HeaderUpdate(msg tea.Msg) (model, tea.Cmd) {
-	if !m.hasMenu || (m.lm != nil && m.lm.SettingFilter()) || (m.contestsList != nil && m.contestsList.SettingFilter()) {
+	if m.help.ShowAll {
+		return m.updateHelp(msg)
+	}
+	// Keys typed into the search box are the search's, and the page's own
+	// keys are the page's
+	if !m.hasHelp() || m.typing() {
 		return m, nil
 	}
-	switch msg := msg.(type) {
-	case tea.KeyPressMsg:
-		switch msg.String() {
+	if msg, ok := msg.(tea.KeyPressMsg); ok && !m.pageKeys().claims(msg) {
+		switch {
+		case key.Matches(msg, m.keys.Help):
+			m.help.ShowAll = true
+		case !m.hasMenu:
+			// The pages before the results have help but no tabs
+		// Plain mode numbers the tabs
+		case m.plain && key.Matches(msg, m.keys.Menu):
+			m = m.openMenuPage(int(msg.Code - '0'))
 		// Or directly navigate to a specific tab
-		case "v", "V":
+		case key.Matches(msg, m.keys.Vote):
 			m.currPage = votePage
-		case "c", "C":
+		case key.Matches(msg, m.keys.Contests):
 			m.currPage = contestsPage
-		case "r", "R":
+		case key.Matches(msg, m.keys.Register):
 			m.currPage = registerPage
-		case "q", "Q": // Quit
+		case key.Matches(msg, m.keys.Timeline):
+			m.currPage = timelinePage
+		case key.Matches(msg, m.keys.NextTab):
+			m.currPage = m.nextTab(1)
+		case key.Matches(msg, m.keys.PrevTab):
+			m.currPage = m.nextTab(-1)
+		case key.Matches(msg, m.keys.Search):
+			return m.showSearch()
+		case key.Matches(msg, m.keys.Notices):
+			m = m.showNotices()
+		case key.Matches(msg, m.keys.Dismiss):
+			m = m.dismissNotices()
+		case key.Matches(msg, m.keys.Addresses):
+			if m.savedList != nil {
+				m = m.showSavedAddresses()
+			}
+		case key.Matches(msg, m.keys.Quit):
 			return m, tea.Quit
 		}
 	}
 	return m, nil
 }
 
+// headerTabs are the pages the header's tabs open, in order.
+var headerTabs = []page{votePage, contestsPage, registerPage, timelinePage}
+
+// nextTab is the header's tab step tabs after the current page's, wrapping
+// around. From a page without a tab it's the first or last.
+func (m model) nextTab(step int) page {
+	i := slices.Index(headerTabs, m.currPage)
+	if i < 0 && step > 0 {
+		return headerTabs[0]
+	} else if i < 0 {
+		return headerTabs[len(headerTabs)-1]
+	}
+	return headerTabs[(i+step+len(headerTabs))%len(headerTabs)]
+}
+
 func (m model) HeaderView() string {
+	header := m.tabBar()
+	if m.plain {
+		header = m.plainHeader()
+	}
+
+	// The notice page already shows the notices in full
+	if m.currPage == noticePage {
+		return header
+	}
+	return joinNonEmptyVertical(lipgloss.Top, header, m.noticeBanner())
+}
+
+// tabBar is the header's row of tabs, boxed in.
+func (m model) tabBar() string {
 	// Define the styles for active and inactive tabs
-	activeTabStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("205")).Render
-	inactiveTabStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render
-	letterStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("205")).Render // Always active style for letter indicators
+	activeTabStyle := lipgloss.NewStyle().Bold(true).Foreground(m.theme.Accent).Render
+	inactiveTabStyle := lipgloss.NewStyle().Foreground(m.theme.Muted).Render
+	letterStyle := lipgloss.NewStyle().Foreground(m.theme.Accent).Render // Always active style for letter indicators
 
 	// Define the tabs with letter indicators
-	title := activeTabStyle("govote.sh")
-	esc := fmt.Sprintf("%s %s", letterStyle("[ESC]"), inactiveTabStyle("Back"))
-	electionDay := fmt.Sprintf("%s %s", letterStyle("[V]"), inactiveTabStyle("Vote"))
-	contests := fmt.Sprintf("%s %s", letterStyle("[C]"), inactiveTabStyle("Contests"))
-	register := fmt.Sprintf("%s %s", letterStyle("[R]"), inactiveTabStyle("Register"))
-
-	// Bold the active tab based on the current page
-	switch m.currPage {
-	case votePage:
-		electionDay = fmt.Sprintf("%s %s", letterStyle("[V]"), activeTabStyle("Vote"))
-	case contestsPage:
-		contests = fmt.Sprintf("%s %s", letterStyle("[C]"), activeTabStyle("Contests"))
-	case registerPage:
-		register = fmt.Sprintf("%s %s", letterStyle("[R]"), activeTabStyle("Register"))
+	// Each tab is labelled with the key that opens it, bold if it's the
+	// current page
+	tab := func(b key.Binding, name string, active bool) string {
+		style := inactiveTabStyle
+		if active {
+			style = activeTabStyle
+		}
+		return fmt.Sprintf("%s %s", letterStyle(keyLabel(b)), style(m.t(name)))
 	}
+	title := activeTabStyle("govote.sh")
+	esc := tab(m.keys.Back, "Back", false)
+	electionDay := tab(m.keys.Vote, "Vote", m.currPage == votePage)
+	contests := tab(m.keys.Contests, "Contests", m.currPage == contestsPage)
+	register := tab(m.keys.Register, "Register", m.currPage == registerPage)
+	timeline := tab(m.keys.Timeline, "Timeline", m.currPage == timelinePage)
+	addresses := tab(m.keys.Addresses, "Addresses", false)
 
 	// Combine the tabs and ensure proper padding to avoid the bar cutting off
-	var tabs []string
-	if m.currPage != pollingPlacePage && m.currPage != contestContentPage {
-		tabs = []string{title, electionDay, contests, register}
-	} else {
-		tabs = []string{title, esc}
+	tabs := []string{title, esc}
+	if !m.subpage() {
+		tabs = []string{title, electionDay, contests, register, timeline}
+		if m.savedList != nil {
+			tabs = append(tabs, addresses)
+		}
 	}
+	// Pad tabs by up to two spaces a side, less if they wouldn't fit
+	natural := len(tabs) + 1 // Borders
+	for _, tab := range tabs {
+		natural += lipgloss.Width(tab)
+	}
+	padding := max(0, min(2, (m.width-2-natural)/(2*len(tabs))))
+
 	return table.New().
 		Border(lipgloss.NormalBorder()).
 		Row(tabs...).
 		Width(m.width - 2). // Add extra space to account for borders
 		StyleFunc(func(row, col int) lipgloss.Style {
 			return lipgloss.NewStyle().
-				Padding(0, 2). // Padding on both sides, including right
+				Padding(0, padding). // Padding on both sides, including right
 				AlignHorizontal(lipgloss.Center)
 		}).
 		Render()
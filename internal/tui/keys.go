@@ -0,0 +1,586 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/help"
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/list"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/govote-sh/govote/internal/i18n"
+)
+
+// keyMap is every key the results pages answer to, with its help in the
+// session's language. Pages match keys against it rather than spelling them
+// out, so the help always says what the keys do, and so the keys a user
+// rebinds are rebound everywhere.
+//
+// A key goes to the first of these that wants it:
+//
+//  1. The session's keys and ctrl+c, on every page
+//  2. The full help, while it's open
+//  3. A text box being typed in: the search's, or a list's filter
+//  4. The page's own keys, those its help lists
+//  5. The header's
+//
+// so a page can take a letter the header would otherwise use, and a
+// rebound key can't steal letters meant for a search.
+type keyMap struct {
+	// The header's, on every results page
+	Vote, Contests, Register, Timeline, Addresses key.Binding
+	NextTab, PrevTab                              key.Binding // Unbound unless overridden
+	Search, Notices, Dismiss                      key.Binding
+	Menu                                          key.Binding // Plain mode's numbered menu
+	Help, Back, Quit, ForceQuit                   key.Binding
+
+	// Anywhere, even over the form
+	Language, Plain, Theme key.Binding
+
+	// The pages'
+	Details            key.Binding // Opens the selected item
+	NextList, PrevList key.Binding
+	ShowClosed         key.Binding
+	Fold, PartyBallot  key.Binding
+	CandidateUp        key.Binding
+	CandidateDown      key.Binding
+	Mark, Compare      key.Binding
+	CompareLeft        key.Binding
+	CompareRight       key.Binding
+	MoreCandidates     key.Binding // Describes CompareLeft and CompareRight together
+	NextOffice         key.Binding
+	PrevOffice         key.Binding
+	Officials          key.Binding
+	Scroll             key.Binding // Only described; viewports have their own keys
+	SearchUp           key.Binding
+	SearchDown         key.Binding
+
+	// Before the results: confirming the matched address, after an error,
+	// and on the saved addresses
+	Accept, Reject     key.Binding
+	Retry, StartOver   key.Binding
+	LookUp, NewAddress key.Binding
+	Delete, ForgetAll  key.Binding
+}
+
+// keyLabel is how the header and hints name b's key, e.g. "[V]".
+func keyLabel(b key.Binding) string {
+	return "[" + strings.ToUpper(b.Help().Key) + "]"
+}
+
+// newKeyMap describes the keys in loc's language, with the user's
+// overrides.
+func newKeyMap(loc i18n.Locale, overrides []keyOverride) keyMap {
+	bind := func(help, desc string, keys ...string) key.Binding {
+		return key.NewBinding(key.WithKeys(keys...), key.WithHelp(help, loc.T(desc)))
+	}
+	k := keyMap{
+		Vote:      bind("v", "vote", "v", "V"),
+		Contests:  bind("c", "contests", "c", "C"),
+		Register:  bind("r", "register", "r", "R"),
+		Timeline:  bind("t", "timeline", "t", "T"),
+		Addresses: bind("a", "saved addresses", "a", "A"),
+		NextTab:   bind("", "next tab"),
+		PrevTab:   bind("", "previous tab"),
+		Search:    bind("/", "search all", "/"),
+		Notices:   bind("n", "read notices", "n", "N"),
+		Dismiss:   bind("x", "dismiss notices", "x", "X"),
+		Menu:      bind("1–5", "open a page", "1", "2", "3", "4", "5"),
+		Help:      bind("?", "more keys", "?"),
+		Back:      bind("esc", "back", "esc"),
+		Quit:      bind("q", "quit", "q", "Q"),
+		ForceQuit: bind("ctrl+c", "quit", "ctrl+c"),
+
+		Language: bind(languageKey, "language", languageKey),
+		Plain:    bind(plainKey, "plain text", plainKey),
+		Theme:    bind(themeKey, "colors", themeKey),
+
+		Details:        bind("enter", "details", "enter"),
+		NextList:       bind("tab", "next list", "tab"),
+		PrevList:       bind("shift+tab", "previous list", "shift+tab"),
+		ShowClosed:     bind("e", "closed sites", "e", "E"),
+		Fold:           bind("space", "fold section", "space"),
+		PartyBallot:    bind("p", "party ballot", "p", "P"),
+		CandidateUp:    bind("↑/k", "up", "up", "k"),
+		CandidateDown:  bind("↓/j", "down", "down", "j"),
+		Mark:           bind("space", "mark to compare", "space"),
+		Compare:        bind("enter", "compare", "enter"),
+		CompareLeft:    bind("←/h", "earlier candidates", "left", "h"),
+		CompareRight:   bind("→/l", "later candidates", "right", "l"),
+		MoreCandidates: bind("←/→", "more candidates"),
+		NextOffice:     bind("tab", "next office", "tab"),
+		PrevOffice:     bind("shift+tab", "previous office", "shift+tab"),
+		Officials:      bind("o", "election officials", "o", "O"),
+		Scroll:         bind("↑/↓", "scroll"),
+		SearchUp:       bind("↑", "up", "up", "ctrl+p"),
+		SearchDown:     bind("↓", "down", "down", "ctrl+n"),
+
+		Accept:     bind("enter", "looks right", "enter", "y", "Y"),
+		Reject:     bind("e", "edit address", "e", "E", "n", "N", "esc"),
+		Retry:      bind("e", "edit address", "enter", "e", "E"),
+		StartOver:  bind("s", "start over", "s", "S"),
+		LookUp:     bind("enter", "look up", "enter"),
+		NewAddress: bind("n", "new address", "n", "N"),
+		Delete:     bind("x", "delete", "x"),
+		ForgetAll:  bind("F", "forget all", "F"),
+	}
+	return k.override(overrides)
+}
+
+// actions are the keys a user can rebind, by the names overrides give
+// them. The session's keys stay put, since the welcome page names them.
+func (k *keyMap) actions() map[string]*key.Binding {
+	return map[string]*key.Binding{
+		"vote":          &k.Vote,
+		"contests":      &k.Contests,
+		"register":      &k.Register,
+		"timeline":      &k.Timeline,
+		"addresses":     &k.Addresses,
+		"next-tab":      &k.NextTab,
+		"prev-tab":      &k.PrevTab,
+		"search":        &k.Search,
+		"notices":       &k.Notices,
+		"dismiss":       &k.Dismiss,
+		"help":          &k.Help,
+		"back":          &k.Back,
+		"quit":          &k.Quit,
+		"details":       &k.Details,
+		"next-list":     &k.NextList,
+		"prev-list":     &k.PrevList,
+		"show-closed":   &k.ShowClosed,
+		"fold":          &k.Fold,
+		"party-ballot":  &k.PartyBallot,
+		"mark":          &k.Mark,
+		"compare":       &k.Compare,
+		"compare-left":  &k.CompareLeft,
+		"compare-right": &k.CompareRight,
+		"next-office":   &k.NextOffice,
+		"prev-office":   &k.PrevOffice,
+		"officials":     &k.Officials,
+		"accept":        &k.Accept,
+		"reject":        &k.Reject,
+		"retry":         &k.Retry,
+		"start-over":    &k.StartOver,
+		"look-up":       &k.LookUp,
+		"new-address":   &k.NewAddress,
+		"delete":        &k.Delete,
+		"forget-all":    &k.ForgetAll,
+	}
+}
+
+// keyOverride rebinds one of the keyMap's actions.
+type keyOverride struct {
+	action string
+	keys   []string
+}
+
+// keyPresets are sets of overrides that can be asked for by name.
+var keyPresets = map[string]string{
+	// Shift+h and shift+l step through the header's tabs, as they step
+	// through a browser's tabs in Vimium
+	"vim": "prev-tab=H,next-tab=L",
+	// Numbers open the header's tabs, as they do in plain mode's menu
+	"numbers": "vote=v V 1,contests=c C 2,register=r R 3,timeline=t T 4,addresses=a A 5",
+}
+
+// parseKeyOverrides reads overrides like "vim,contests=c 2": presets and
+// action=keys pairs separated by commas, with the keys separated by spaces
+// and spelled as Bubble Tea spells them, e.g. "ctrl+n" or "space". Later
+// overrides win. Parts it can't read are left out and reported in err.
+func parseKeyOverrides(spec string) ([]keyOverride, error) {
+	actions := (&keyMap{}).actions()
+	var overrides []keyOverride
+	var errs []error
+	for part := range strings.SplitSeq(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		action, keys, ok := strings.Cut(part, "=")
+		if !ok {
+			preset, ok := keyPresets[part]
+			if !ok {
+				errs = append(errs, fmt.Errorf("unknown key preset %q", part))
+				continue
+			}
+			o, _ := parseKeyOverrides(preset)
+			overrides = append(overrides, o...)
+			continue
+		}
+		action = strings.TrimSpace(action)
+		if _, ok := actions[action]; !ok {
+			errs = append(errs, fmt.Errorf("unknown key action %q", action))
+			continue
+		}
+		fields := strings.Fields(keys)
+		if len(fields) == 0 {
+			errs = append(errs, fmt.Errorf("no keys for %q", action))
+			continue
+		}
+		overrides = append(overrides, keyOverride{action: action, keys: fields})
+	}
+	return overrides, errors.Join(errs...)
+}
+
+// override rebinds k's actions. A rebound key's help lists its new keys,
+// leaving out the capitals of letters it also lists.
+func (k keyMap) override(overrides []keyOverride) keyMap {
+	actions := k.actions()
+	for _, o := range overrides {
+		b, ok := actions[o.action]
+		if !ok {
+			continue
+		}
+		var shown []string
+		for _, key := range o.keys {
+			if lower := strings.ToLower(key); lower != key && slices.Contains(o.keys, lower) {
+				continue
+			}
+			shown = append(shown, key)
+		}
+		b.SetKeys(o.keys...)
+		b.SetHelp(strings.Join(shown, "/"), b.Help().Desc)
+	}
+	return k
+}
+
+// conflicts lists the keys bound to more than one of the header's
+// actions, of which only the first listed in the keyMap answers.
+func (k keyMap) conflicts() []string {
+	header := []key.Binding{k.Vote, k.Contests, k.Register, k.Timeline, k.Addresses, k.NextTab, k.PrevTab, k.Search, k.Notices, k.Dismiss, k.Help, k.Quit}
+	seen := map[string]bool{}
+	var conflicts []string
+	for _, b := range header {
+		for _, key := range b.Keys() {
+			if seen[key] && !slices.Contains(conflicts, key) {
+				conflicts = append(conflicts, key)
+			}
+			seen[key] = true
+		}
+	}
+	return conflicts
+}
+
+// withKeys rebinds the session's keys as spec asks, in the form
+// parseKeyOverrides reads. Parts it can't read are left out.
+func (m model) withKeys(spec string) model {
+	m.keyOverrides, _ = parseKeyOverrides(spec)
+	m.keys = newKeyMap(m.locale, m.keyOverrides)
+	return m
+}
+
+// claims reports whether msg is one of the page's own keys, which it
+// answers to before the header does.
+func (k pageKeys) claims(msg tea.KeyPressMsg) bool {
+	return key.Matches(msg, k.page...) || key.Matches(msg, k.hidden...)
+}
+
+// typing is whether keys are going into a text box, the search's or the
+// saved addresses' filter, rather than being taken as shortcuts.
+func (m model) typing() bool {
+	switch m.currPage {
+	case searchPage:
+		return true
+	case savedAddressPage:
+		return m.savedList != nil && m.savedList.SettingFilter()
+	}
+	return false
+}
+
+// pageKeys are the keys one page answers to, as the help footer and
+// overlay show them.
+type pageKeys struct {
+	page    []key.Binding // The page's own
+	hidden  []key.Binding // The page's own that others describe
+	nav     []key.Binding // Going back or quitting, and help
+	tabs    []key.Binding // The header's
+	session []key.Binding // Language, plain mode and colors
+}
+
+// ShortHelp is the page's keys, then how to leave it or get more help.
+func (k pageKeys) ShortHelp() []key.Binding {
+	return append(append([]key.Binding{}, k.page...), k.nav...)
+}
+
+// FullHelp groups every key: the page's, the header's, then the rest.
+func (k pageKeys) FullHelp() [][]key.Binding {
+	return [][]key.Binding{k.page, k.tabs, append(append([]key.Binding{}, k.nav...), k.session...)}
+}
+
+// hasHelp is whether the page has the help footer and full help: the
+// results pages, and the pages about the address before them.
+func (m model) hasHelp() bool {
+	switch m.currPage {
+	case savedAddressPage, confirmAddressPage, reinputConfirmationPage:
+		return true
+	}
+	return m.hasMenu
+}
+
+// subpage is whether the current page was opened from one of the header's,
+// and goes back to it with esc.
+func (m model) subpage() bool {
+	switch m.currPage {
+	case pollingPlacePage, contestContentPage, comparePage, referendumPage, officialsPage, officialPage, noticePage, searchPage:
+		return true
+	}
+	return false
+}
+
+// parentPage is the page a subpage goes back to.
+func (m model) parentPage() page {
+	switch m.currPage {
+	case pollingPlacePage:
+		return votePage
+	case contestContentPage, referendumPage:
+		return contestsPage
+	case comparePage:
+		return contestContentPage
+	case officialsPage:
+		return registerPage
+	case officialPage:
+		return officialsPage
+	case noticePage:
+		return m.noticeReturn
+	case searchPage:
+		return m.searchReturn
+	}
+	return m.currPage
+}
+
+// listKeys are a list's keys for moving through it.
+func listKeys(l *list.Model) []key.Binding {
+	if l == nil {
+		return nil
+	}
+	return []key.Binding{l.KeyMap.CursorUp, l.KeyMap.CursorDown, l.KeyMap.PrevPage, l.KeyMap.NextPage}
+}
+
+// pageKeys are the keys the current page answers to, each enabled only
+// when pressing it would do something.
+func (m model) pageKeys() pageKeys {
+	k := m.keys
+	var page, hidden []key.Binding
+	switch m.currPage {
+	case votePage:
+		if m.lm != nil {
+			active := m.lm.ActiveList()
+			page = append(listKeys(&active), k.Details, k.NextList, k.PrevList)
+		}
+		showClosed := k.ShowClosed
+		if n := m.expiredSites(); n == 0 {
+			showClosed.SetEnabled(false)
+		} else if m.showExpired {
+			showClosed.SetHelp(showClosed.Help().Key, m.t("hide closed sites"))
+		} else {
+			showClosed.SetHelp(showClosed.Help().Key, m.tf("show %d closed", n))
+		}
+		page = append(page, showClosed)
+	case contestsPage:
+		partyBallot := k.PartyBallot
+		partyBallot.SetEnabled(len(m.primaryParties()) > 0)
+		page = append(listKeys(m.contestsList), k.Details, k.Fold, partyBallot)
+	case contestContentPage:
+		// Candidates can be marked and compared in races with two or more
+		if contest, ok := m.selectedContest(); ok && len(contest.Candidates) >= 2 {
+			mark := k.Mark
+			if n := len(m.markedCandidates()); n > 0 {
+				mark.SetHelp(mark.Help().Key, m.tf("mark to compare (%d marked)", n))
+			}
+			compare := k.Compare
+			compare.SetEnabled(len(m.markedCandidates()) >= 2)
+			page = []key.Binding{k.CandidateUp, k.CandidateDown, mark, compare}
+		}
+	case comparePage:
+		n := len(m.markedCandidates())
+		count, _ := m.compareColumns(n)
+		if count < n {
+			offset := max(0, min(m.compareOffset, n-count))
+			more := k.MoreCandidates
+			more.SetHelp(more.Help().Key, m.tf("more candidates (%d–%d of %d)", offset+1, offset+count, n))
+			page = []key.Binding{more}
+		}
+		hidden = []key.Binding{k.CompareLeft, k.CompareRight}
+	case referendumPage:
+		scroll := k.Scroll
+		scroll.SetEnabled(!m.referendumView.AtTop() || !m.referendumView.AtBottom())
+		page = []key.Binding{scroll}
+	case registerPage:
+		nextOffice, prevOffice, officials, scroll := k.NextOffice, k.PrevOffice, k.Officials, k.Scroll
+		nextOffice.SetEnabled(len(m.adminPanels()) > 1)
+		prevOffice.SetEnabled(len(m.adminPanels()) > 1)
+		if m.officialsList != nil && len(m.officialsList.Items()) > 0 {
+			officials.SetHelp(officials.Help().Key, m.tf("election officials (%d)", len(m.officialsList.Items())))
+		} else {
+			officials.SetEnabled(false)
+		}
+		scroll.SetEnabled(!m.registerView.AtTop() || !m.registerView.AtBottom())
+		page = []key.Binding{nextOffice, prevOffice, officials, scroll}
+	case officialsPage:
+		page = append(listKeys(m.officialsList), k.Details)
+	case timelinePage:
+		page = listKeys(m.timelineList)
+	case noticePage:
+		scroll := k.Scroll
+		scroll.SetEnabled(!m.noticeView.AtTop() || !m.noticeView.AtBottom())
+		page = []key.Binding{scroll}
+	case searchPage:
+		page = []key.Binding{k.SearchUp, k.SearchDown, k.Details}
+	case confirmAddressPage:
+		page = []key.Binding{k.Accept, k.Reject}
+	case reinputConfirmationPage:
+		page = []key.Binding{k.Retry, k.StartOver}
+	case savedAddressPage:
+		if m.savedList == nil {
+			break
+		}
+		if m.typing() {
+			page = []key.Binding{m.savedList.KeyMap.AcceptWhileFiltering, m.savedList.KeyMap.CancelWhileFiltering}
+			break
+		}
+		if m.deleting != nil {
+			accept, reject := k.Accept, k.Reject
+			accept.SetHelp(accept.Help().Key, m.t("delete"))
+			reject.SetHelp(reject.Help().Key, m.t("keep"))
+			page = []key.Binding{accept, reject}
+			break
+		}
+		page = append(listKeys(m.savedList), m.savedList.KeyMap.Filter, m.savedList.KeyMap.ClearFilter, k.LookUp, k.NewAddress, k.Delete, k.ForgetAll)
+	}
+
+	back, quit, help := k.Back, k.Quit, k.Help
+	switch {
+	case m.typing():
+		// Letters typed on the search page are the search's
+		quit.SetEnabled(false)
+		help.SetEnabled(false)
+	case m.subpage():
+		quit.SetEnabled(false)
+	case m.currPage == savedAddressPage && m.deleting != nil:
+		// Esc keeps the addresses rather than going back
+		back.SetEnabled(false)
+	case m.currPage == savedAddressPage && m.electionData != nil:
+		back.SetHelp(back.Help().Key, m.t("back to results"))
+	default:
+		back.SetEnabled(false)
+	}
+	help.SetEnabled(help.Enabled() && m.hasHelp())
+
+	addresses, notices, dismiss, menu := k.Addresses, k.Notices, k.Dismiss, k.Menu
+	addresses.SetEnabled(m.savedList != nil)
+	notices.SetEnabled(len(m.electionNotices()) > 0)
+	dismiss.SetEnabled(m.noticeBanner() != "")
+	menu.SetEnabled(m.plain)
+	tabs := []key.Binding{menu, k.Vote, k.Contests, k.Register, k.Timeline, addresses, k.PrevTab, k.NextTab, k.Search, notices, dismiss}
+	if !m.hasMenu {
+		tabs = nil
+	}
+
+	language := k.Language
+	language.SetHelp(language.Help().Key, m.locale.Next().Name())
+	plain := k.Plain
+	if m.plain {
+		plain.SetHelp(plain.Help().Key, m.t("full screen"))
+	}
+	return pageKeys{
+		page:    page,
+		hidden:  hidden,
+		nav:     []key.Binding{back, quit, help},
+		tabs:    tabs,
+		session: []key.Binding{language, plain, k.Theme},
+	}
+}
+
+// newHelp is the help model drawn in the theme's colors, or in plain mode
+// with none.
+func (m model) newHelp() help.Model {
+	h := m.help
+	h.SetWidth(max(0, m.width-2))
+	if m.plain {
+		h.Styles = help.Styles{}
+		h.ShortSeparator = ", "
+		return h
+	}
+	h.Styles.ShortKey = lipgloss.NewStyle().Foreground(m.theme.Accent)
+	h.Styles.ShortDesc = lipgloss.NewStyle().Foreground(m.theme.Muted)
+	h.Styles.ShortSeparator = lipgloss.NewStyle().Foreground(m.theme.Faint)
+	h.Styles.FullKey = h.Styles.ShortKey
+	h.Styles.FullDesc = h.Styles.ShortDesc
+	h.Styles.FullSeparator = h.Styles.ShortSeparator
+	h.Styles.Ellipsis = h.Styles.ShortSeparator
+	return h
+}
+
+// helpFooter is the page's most used keys, shown at the foot of every
+// results page. Lists draw it in place of their own help. A web address
+// just copied, or a notice, shows in its place until the next key.
+func (m model) helpFooter() string {
+	var message string
+	switch {
+	case m.copied != "":
+		message = m.tf("Copied %s", m.copied)
+	case m.notice != "":
+		message = m.t(m.notice)
+	}
+	if message != "" {
+		// Just as tall as the help, so the page doesn't move
+		message = lipgloss.NewStyle().Foreground(m.theme.Accent).MaxWidth(max(0, m.width-2)).Render(message)
+		return lipgloss.NewStyle().MarginTop(1).Render(message)
+	}
+	return lipgloss.NewStyle().MarginTop(1).Render(m.newHelp().ShortHelpView(m.pageKeys().ShortHelp()))
+}
+
+// withFooter hides a list's own help, which the help footer replaces, and
+// its quit keys, leaving quitting to the keyMap's.
+func withFooter(l *list.Model) {
+	l.SetShowHelp(false)
+	l.KeyMap.ShowFullHelp.SetEnabled(false)
+	l.KeyMap.CloseFullHelp.SetEnabled(false)
+	l.DisableQuitKeybindings()
+}
+
+// updateHelp handles keys while the full help is showing: help or esc
+// closes it, and the rest do nothing so the page underneath stays put.
+func (m model) updateHelp(msg tea.Msg) (model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyPressMsg)
+	if !ok {
+		return m, nil
+	}
+	switch {
+	case key.Matches(keyMsg, m.keys.Help, m.keys.Back):
+		m.help.ShowAll = false
+	case key.Matches(keyMsg, m.keys.Quit):
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+// viewHelp lists every key the page answers to, under the header.
+func (m model) viewHelp() string {
+	h := m.newHelp()
+	keys := m.pageKeys()
+	groups := keys.FullHelp()
+	titles := []string{m.t("This page"), m.t("Pages"), m.t("Anywhere")}
+
+	var sections []string
+	for i, group := range groups {
+		if column := h.FullHelpView([][]key.Binding{group}); column != "" {
+			sections = append(sections, m.theme.sectionTitle(titles[i])+"\n"+column)
+		}
+	}
+	closeHelp := m.keys.Help
+	closeHelp.SetHelp(closeHelp.Help().Key, m.t("close help"))
+	var header string
+	if m.hasMenu {
+		header = m.HeaderView()
+	}
+	return lipgloss.NewStyle().Margin(1, 1).MaxWidth(m.width).MaxHeight(m.height).Render(joinNonEmptyVertical(
+		lipgloss.Top,
+		header,
+		strings.Join(sections, "\n\n"),
+		lipgloss.NewStyle().MarginTop(1).Render(h.ShortHelpView([]key.Binding{closeHelp})),
+	))
+}
@@ -0,0 +1,67 @@
+package tui
+
+import (
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/govote-sh/govote/internal/address"
+	"github.com/govote-sh/govote/internal/i18n"
+)
+
+// languageKey switches to the next language on any page. It's a control
+// key so it can't be mistaken for typing in the address form.
+const languageKey = "ctrl+l"
+
+// t translates msg into the session's language.
+func (m model) t(msg string) string { return m.locale.T(msg) }
+
+// tf translates a format string into the session's language and formats
+// it with args.
+func (m model) tf(format string, args ...any) string { return m.locale.Tf(format, args...) }
+
+// withLocale shows the interface in l from the start of a session.
+func (m model) withLocale(l i18n.Locale) model {
+	m.locale = l
+	m.keys = newKeyMap(l, m.keyOverrides)
+	m.form = m.newAddressForm(m.input)
+	return m
+}
+
+// toggleLocale switches to the next language.
+func (m model) toggleLocale() (model, tea.Cmd) {
+	m.locale = m.locale.Next()
+	m.keys = newKeyMap(m.locale, m.keyOverrides)
+	return m.redraw()
+}
+
+// formValues is what's been typed into the address form so far, and the
+// key of the field being typed in.
+func formValues(m model) (address.InputAddress, string) {
+	values := map[string]string{}
+	for _, k := range addressFieldKeys {
+		values[k] = m.form.GetString(k)
+	}
+	// The form only records a field's value when the user moves on from it
+	focused := addressFieldKeys[0]
+	if field := m.form.GetFocusedField(); field != nil {
+		if v, ok := field.GetValue().(string); ok {
+			if _, isAddress := values[field.GetKey()]; isAddress {
+				values[field.GetKey()] = v
+				focused = field.GetKey()
+			}
+		}
+	}
+	return address.InputAddress{
+		Street:     values["street"],
+		City:       values["city"],
+		State:      values["state"],
+		PostalCode: values["postal_code"],
+	}, focused
+}
+
+// languageHint offers the next language, named in that language so
+// someone who can't read the current one still recognizes it.
+func (m model) languageHint() string {
+	keyStyle := lipgloss.NewStyle().Foreground(m.theme.Accent).Render
+	hintStyle := lipgloss.NewStyle().Foreground(m.theme.Muted).Render
+	return keyStyle("[Ctrl+L]") + hintStyle(" "+m.locale.Next().Name())
+}
@@ -1,6 +1,33 @@
 package tui
 
-import "testing"
+import (
+	"bytes"
+	"context"
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/list"
+	tea "charm.land/bubbletea/v2"
+	huh "charm.land/huh/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/colorprofile"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/govote-sh/govote/internal/address"
+	"github.com/govote-sh/govote/internal/api"
+	"github.com/govote-sh/govote/internal/domain"
+	"github.com/govote-sh/govote/internal/i18n"
+	"github.com/govote-sh/govote/internal/store"
+	"github.com/govote-sh/govote/internal/utils"
+)
 
 func TestNewModelInitialState(t *testing.T) {
 	m := newModel(80, 24)
@@ -18,3 +45,1247 @@ func TestNewModelInitialState(t *testing.T) {
 		t.Errorf("size = %dx%d, want 80x24", m.width, m.height)
 	}
 }
+
+// lookupMsgModel returns a model sitting on the loading page after the user
+// submitted input, as if CheckServer were in flight.
+func lookupMsgModel(input address.InputAddress) model {
+	m := newModel(80, 24)
+	m.input = input
+	m.currPage = loadingPage
+	return m
+}
+
+func update(t *testing.T, m model, msg tea.Msg) model {
+	t.Helper()
+	next, _ := m.Update(msg)
+	nm, ok := next.(model)
+	if !ok {
+		t.Fatalf("Update returned %T, want model", next)
+	}
+	return nm
+}
+
+func TestLookupWithNormalizedInputAsksForConfirmation(t *testing.T) {
+	data := fixtureVoterInfo()
+	data.NormalizedInput = api.Address{Line1: "1234 W Broad St", City: "Richmond", State: "VA", Zip: "23220"}
+	m := update(t, lookupMsgModel(address.InputAddress{Street: "1234 broad", City: "Richmond", State: "VA"}), data)
+
+	if m.currPage != confirmAddressPage {
+		t.Fatalf("currPage = %v, want confirmAddressPage", m.currPage)
+	}
+	if m.hasMenu {
+		t.Error("hasMenu = true before the user confirmed the address")
+	}
+
+	accepted := update(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if accepted.currPage != votePage || !accepted.hasMenu || accepted.lm == nil || accepted.contestsList == nil {
+		t.Errorf("after enter: currPage = %v, hasMenu = %v; want votePage with lists built", accepted.currPage, accepted.hasMenu)
+	}
+
+	edited := update(t, m, tea.KeyPressMsg{Code: 'e', Text: "e"})
+	if edited.currPage != inputPage {
+		t.Fatalf("after e: currPage = %v, want inputPage", edited.currPage)
+	}
+	if !strings.Contains(edited.form.View(), "1234 broad") {
+		t.Error("form was not prefilled with the entered street address")
+	}
+}
+
+func TestLookupWithoutNormalizedInputSkipsConfirmation(t *testing.T) {
+	m := update(t, lookupMsgModel(address.InputAddress{City: "Richmond"}), fixtureVoterInfo())
+
+	if m.currPage != votePage {
+		t.Errorf("currPage = %v, want votePage", m.currPage)
+	}
+}
+
+func TestCompareAddressHighlightsOnlyRealChanges(t *testing.T) {
+	fields := compareAddress(
+		address.InputAddress{Street: "1234  w broad st", City: "Richmnd", State: "VA"},
+		domain.Address{Line1: "1234 W Broad St", City: "Richmond", State: "VA", Zip: "23220"},
+	)
+
+	want := map[string]bool{
+		"Street Address": false, // case and spacing only
+		"City":           true,
+		"State":          false,
+		"Postal Code":    true, // filled in by the API
+	}
+	for _, f := range fields {
+		if got := f.changed(); got != want[f.label] {
+			t.Errorf("%s changed() = %v, want %v", f.label, got, want[f.label])
+		}
+	}
+}
+
+func TestEditAfterErrorKeepsInputAndFocusesSuspectField(t *testing.T) {
+	input := address.InputAddress{Street: "1234 W Broad St", City: "Richmond", State: "VZ"}
+	m := newModel(80, 24)
+	m.input = input
+	m.err = &utils.ErrMsg{Err: api.ErrNoElectionDay, HTTPStatusCode: 200}
+	m.currPage = reinputConfirmationPage
+
+	edited := update(t, m, tea.KeyPressMsg{Code: 'e', Text: "e"})
+	if edited.currPage != inputPage {
+		t.Fatalf("currPage = %v, want inputPage", edited.currPage)
+	}
+	if edited.input != input {
+		t.Errorf("input = %+v, want %+v", edited.input, input)
+	}
+	if got := edited.form.GetFocusedField().GetKey(); got != "state" {
+		t.Errorf("focused field = %q, want %q", got, "state")
+	}
+	if !strings.Contains(edited.form.View(), "1234 W Broad St") {
+		t.Error("form was not prefilled with the entered street address")
+	}
+
+	restarted := update(t, m, tea.KeyPressMsg{Code: 's', Text: "s"})
+	if restarted.input != (address.InputAddress{}) {
+		t.Errorf("start over kept input %+v", restarted.input)
+	}
+	if strings.Contains(restarted.form.View(), "1234 W Broad St") {
+		t.Error("start over form still holds the old street address")
+	}
+}
+
+func TestSuspectAddressField(t *testing.T) {
+	tests := []struct {
+		name  string
+		err   *utils.ErrMsg
+		input address.InputAddress
+		want  string
+	}{
+		{"empty submission", &utils.ErrMsg{}, address.InputAddress{}, "street"},
+		{"no election found", &utils.ErrMsg{Err: api.ErrNoElectionDay}, address.InputAddress{Street: "1 Main St", State: "VA"}, "state"},
+		{"parse error with missing city", &utils.ErrMsg{HTTPStatusCode: 400, Reason: "parseError"}, address.InputAddress{Street: "1 Main St", State: "VA"}, "city"},
+		{"parse error with everything filled", &utils.ErrMsg{HTTPStatusCode: 400, Reason: "parseError"}, address.InputAddress{Street: "1 Main St", City: "Richmond", State: "VA"}, "street"},
+		{"server error", &utils.ErrMsg{HTTPStatusCode: 503}, address.InputAddress{City: "Richmond"}, "street"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := suspectAddressField(tt.err, tt.input); got != tt.want {
+				t.Errorf("suspectAddressField() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func newTestStore(t *testing.T) *store.Store {
+	t.Helper()
+	st, err := store.New(t.TempDir(), bytes.Repeat([]byte{7}, 32), 24*time.Hour)
+	if err != nil {
+		t.Fatalf("store.New: %v", err)
+	}
+	return st
+}
+
+// testUserID is any well-formed store ID; sessions get theirs from UserID.
+var testUserID = strings.Repeat("ab", 32)
+
+func TestRememberedAddressIsSavedOnlyAfterConfirmation(t *testing.T) {
+	st := newTestStore(t)
+	m := newModel(80, 24).withStore(st, testUserID)
+	if m.currPage != inputPage {
+		t.Fatalf("currPage = %v, want inputPage for a user with nothing saved", m.currPage)
+	}
+
+	m.input = address.InputAddress{Street: "1234 W Broad St", City: "Richmond"}
+	m.currPage = loadingPage
+	m.remember = "home"
+	data := fixtureVoterInfo()
+	data.NormalizedInput = api.Address{Line1: "1234 W Broad St", City: "Richmond", State: "VA"}
+	m = update(t, m, data)
+	if _, err := st.Load(testUserID); !errors.Is(err, store.ErrNotFound) {
+		t.Fatalf("address saved before the user confirmed it: err = %v", err)
+	}
+
+	next, cmd := m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	m = next.(model)
+	for _, msg := range runCmd(cmd) {
+		m = update(t, m, msg)
+	}
+	entry, err := st.Load(testUserID)
+	if err != nil {
+		t.Fatalf("Load after confirming: %v", err)
+	}
+	want := []store.SavedAddress{{Label: "home", Address: m.input}}
+	if !slices.Equal(entry.Addresses, want) {
+		t.Errorf("saved %+v, want %+v", entry.Addresses, want)
+	}
+	if m.savedList == nil || len(m.savedList.Items()) != 1 {
+		t.Error("saved address list was not updated after saving")
+	}
+}
+
+func TestSwitchBetweenSavedAddresses(t *testing.T) {
+	st := newTestStore(t)
+	home := address.InputAddress{Street: "1234 W Broad St", City: "Richmond", State: "VA"}
+	campus := address.InputAddress{Street: "400 Hull St", City: "Richmond", State: "VA"}
+	for label, addr := range map[string]address.InputAddress{"home": home, "campus": campus} {
+		if _, err := st.Save(testUserID, label, addr); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+	}
+	fake := &countingProvider{}
+
+	m := newModel(80, 24).withStore(st, testUserID)
+	m.provider = api.NewCachingProvider(fake, time.Hour, 10)
+	if m.currPage != savedAddressPage {
+		t.Fatalf("currPage = %v, want savedAddressPage", m.currPage)
+	}
+
+	// Look up each address, then the first one again
+	lookUp := func(index int) {
+		t.Helper()
+		m.savedList.Select(index)
+		next, cmd := m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+		m = next.(model)
+		for _, msg := range runCmd(cmd) {
+			if _, ok := msg.(api.VoterInfoResponse); ok {
+				m = update(t, m, msg)
+			}
+		}
+		if m.currPage != votePage {
+			t.Fatalf("currPage = %v after looking up a saved address, want votePage", m.currPage)
+		}
+		m = update(t, m, tea.KeyPressMsg{Code: 'a', Text: "a"})
+		if m.currPage != savedAddressPage {
+			t.Fatalf("currPage = %v after pressing a, want savedAddressPage", m.currPage)
+		}
+	}
+	lookUp(0)
+	lookUp(1)
+	lookUp(0)
+
+	if fake.calls != 2 {
+		t.Errorf("provider called %d times, want 2 (the repeat lookup is cached)", fake.calls)
+	}
+
+	// Deleting one, once confirmed, leaves the other
+	m = update(t, m, tea.KeyPressMsg{Code: 'x', Text: "x"})
+	next, cmd := m.Update(tea.KeyPressMsg{Code: 'y', Text: "y"})
+	m = next.(model)
+	for _, msg := range runCmd(cmd) {
+		m = update(t, m, msg)
+	}
+	if got := len(m.savedList.Items()); got != 1 {
+		t.Errorf("%d saved addresses after deleting one, want 1", got)
+	}
+}
+
+func TestForgetAllSavedAddresses(t *testing.T) {
+	st := newTestStore(t)
+	if _, err := st.Save(testUserID, "home", address.InputAddress{City: "Richmond"}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	m := newModel(80, 24).withStore(st, testUserID)
+	m = update(t, m, tea.KeyPressMsg{Code: 'F', Text: "F", Mod: tea.ModShift})
+	if !strings.Contains(ansi.Strip(m.View().Content), "Delete all 1 saved addresses?") {
+		t.Errorf("F does not ask before deleting:\n%s", m.View().Content)
+	}
+	next, cmd := m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m = next.(model); m.notice != "" {
+		t.Errorf("notice = %q before the addresses were deleted", m.notice)
+	}
+	for _, msg := range runCmd(cmd) {
+		m = update(t, m, msg)
+	}
+	if m.currPage != inputPage || m.savedList != nil {
+		t.Errorf("after confirming F: currPage = %v, savedList = %v; want inputPage and nothing saved", m.currPage, m.savedList != nil)
+	}
+	if !strings.Contains(ansi.Strip(m.View().Content), "Your saved addresses have been deleted.") {
+		t.Error("no notice that the saved addresses were deleted")
+	}
+	if _, err := st.Load(testUserID); !errors.Is(err, store.ErrNotFound) {
+		t.Errorf("Load after forgetting: err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestUnconfirmedForgetKeepsSavedAddresses(t *testing.T) {
+	st := newTestStore(t)
+	if _, err := st.Save(testUserID, "home", address.InputAddress{City: "Richmond"}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	m := newModel(80, 24).withStore(st, testUserID)
+	for _, keyMsg := range []tea.KeyPressMsg{{Code: 'F', Text: "F", Mod: tea.ModShift}, {Code: tea.KeyEscape}} {
+		next, cmd := m.Update(keyMsg)
+		m = next.(model)
+		for _, msg := range runCmd(cmd) {
+			m = update(t, m, msg)
+		}
+	}
+	if m.currPage != savedAddressPage || m.deleting != nil {
+		t.Errorf("after F then esc: currPage = %v, deleting = %v; want the saved addresses back", m.currPage, m.deleting)
+	}
+	if entry, err := st.Load(testUserID); err != nil || len(entry.Addresses) != 1 {
+		t.Errorf("Load after declining: %+v, %v; want the address kept", entry, err)
+	}
+}
+
+func TestFailedSaveShowsANotice(t *testing.T) {
+	st := newTestStore(t)
+	for i := range store.MaxAddresses {
+		if _, err := st.Save(testUserID, strconv.Itoa(i), address.InputAddress{City: "Richmond"}); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+	}
+	m := newModel(80, 24).withStore(st, testUserID)
+	m.input = address.InputAddress{Street: "1234 W Broad St", City: "Richmond"}
+	m.currPage = loadingPage
+	m.remember = "one too many"
+	data := fixtureVoterInfo()
+	data.NormalizedInput = api.Address{Line1: "1234 W Broad St", City: "Richmond", State: "VA"}
+	m = update(t, m, data)
+
+	next, cmd := m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	m = next.(model)
+	for _, msg := range runCmd(cmd) {
+		m = update(t, m, msg)
+	}
+	if footer := ansi.Strip(m.helpFooter()); !strings.Contains(footer, "Address not saved") {
+		t.Errorf("footer = %q, want why the address was not saved", footer)
+	}
+	if m = update(t, m, tea.KeyPressMsg{Code: tea.KeyDown}); m.notice != "" {
+		t.Error("the notice outlasted a key press")
+	}
+}
+
+// countingProvider answers every lookup with the fixture and counts calls.
+type countingProvider struct {
+	calls int
+}
+
+func (p *countingProvider) VoterInfo(context.Context, address.InputAddress) (api.VoterInfoResponse, error) {
+	p.calls++
+	return fixtureVoterInfo(), nil
+}
+
+// runCmd runs cmd and, recursively, any batched commands it returns,
+// collecting their messages. Commands that block (like the cursor blink)
+// are abandoned.
+func runCmd(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	done := make(chan tea.Msg, 1)
+	go func() { done <- cmd() }()
+	select {
+	case msg := <-done:
+		batch, ok := msg.(tea.BatchMsg)
+		if !ok {
+			return []tea.Msg{msg}
+		}
+		var msgs []tea.Msg
+		for _, c := range batch {
+			msgs = append(msgs, runCmd(c)...)
+		}
+		return msgs
+	case <-time.After(100 * time.Millisecond):
+		return nil
+	}
+}
+
+func TestRelativeDates(t *testing.T) {
+	now := time.Date(2026, time.October, 20, 23, 30, 0, 0, time.Local)
+	day := func(d int) time.Time { return time.Date(2026, time.October, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name       string
+		start, end time.Time
+		want       string
+	}{
+		{"not open yet", day(22), day(30), "starts in 2 days"},
+		{"opens tomorrow", day(21), day(30), "starts tomorrow"},
+		{"open", day(10), day(20), "open now, ends today"},
+		{"closed", day(10), day(17), "ended 3 days ago"},
+		{"no end date", day(10), time.Time{}, "open now"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := relativeWindow(tt.start, tt.end, now, i18n.English); got != tt.want {
+				t.Errorf("relativeWindow = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if got := formatDate(day(20), i18n.English); got != "Tuesday, October 20, 2026" {
+		t.Errorf("formatDate = %q", got)
+	}
+}
+
+func TestMalformedDatesAreNotShown(t *testing.T) {
+	data := fixtureVoterInfo()
+	data.Election.ElectionDay = "11/03/2026"
+	m := update(t, lookupMsgModel(address.InputAddress{City: "Richmond"}), data)
+	m.now = fixtureNow
+
+	view := m.View().Content
+	if strings.Contains(view, "11/03/2026") {
+		t.Error("malformed election day was displayed")
+	}
+	if !strings.Contains(view, "could not be read") {
+		t.Error("vote page does not mention the unreadable value")
+	}
+}
+
+func TestExpiredSitesHiddenUntilToggled(t *testing.T) {
+	data := fixtureVoterInfo()
+	data.EarlyVoteSites = []api.PollingPlace{
+		{Name: "Library", StartDate: "2026-10-01", EndDate: "2026-10-10"},
+		{Name: "City Hall", StartDate: "2026-10-15", EndDate: "2026-10-31"},
+	}
+	m := newModel(80, 40)
+	m.now = fixtureNow
+	m = update(t, m, tea.WindowSizeMsg{Width: 80, Height: 40})
+	m.currPage = loadingPage
+	m = update(t, m, data)
+	m.lm.CycleNext()
+
+	if view := m.View().Content; strings.Contains(view, "Library") || !strings.Contains(view, "City Hall") {
+		t.Fatalf("expired site listed by default:\n%s", view)
+	}
+
+	m = update(t, m, tea.KeyPressMsg{Code: 'e', Text: "e"})
+	view := m.View().Content
+	if !strings.Contains(view, "Library") || !strings.Contains(view, "Closed 10 days ago") {
+		t.Errorf("expired site not listed after toggling:\n%s", view)
+	}
+	if !strings.Contains(view, "City Hall") {
+		t.Error("open site disappeared after toggling")
+	}
+}
+
+func TestRegisterPanelsAndOfficials(t *testing.T) {
+	data := fixtureVoterInfo()
+	data.State = append(data.State, api.State{Name: "Other State"})
+	data.State[0].LocalJurisdiction = &api.AdministrationRegion{
+		Name: "Richmond City",
+		ElectionAdministrationBody: api.ElectionAdministrationBody{
+			Name: "Richmond City Registrar",
+			ElectionOfficials: []api.ElectionOfficial{
+				{Name: "Pat Doe", Title: "General Registrar", OfficePhoneNumber: "555-0100", EmailAddress: "pat@example.gov"},
+			},
+		},
+	}
+	m := newModel(80, 40)
+	m.now = fixtureNow
+	m = update(t, m, tea.WindowSizeMsg{Width: 80, Height: 40})
+	m.currPage = loadingPage
+	m = update(t, m, data)
+	m.currPage = registerPage
+
+	view := m.View().Content
+	if !strings.Contains(view, "Register in Test State") || !strings.Contains(view, "Local: Richmond City") || !strings.Contains(view, "State: Other State") {
+		t.Fatalf("register page should show the state panel and list the others:\n%s", view)
+	}
+
+	m = update(t, m, tea.KeyPressMsg{Code: tea.KeyTab})
+	view = m.View().Content
+	if !strings.Contains(view, "Local Jurisdiction: Richmond City") || !strings.Contains(view, "election officials (1)") {
+		t.Fatalf("tab should switch to the local panel:\n%s", view)
+	}
+
+	m = update(t, m, tea.KeyPressMsg{Code: 'o', Text: "o"})
+	if m.currPage != officialsPage {
+		t.Fatalf("page = %v, want officialsPage", m.currPage)
+	}
+	if view := m.View().Content; !strings.Contains(view, "details") || !strings.Contains(view, "esc") {
+		t.Errorf("officials page has no help footer:\n%s", view)
+	}
+	m = update(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	view = m.View().Content
+	if m.currPage != officialPage || !strings.Contains(view, "pat@example.gov") {
+		t.Fatalf("enter should open the official's details:\n%s", view)
+	}
+
+	m = update(t, m, tea.KeyPressMsg{Code: tea.KeyEscape})
+	m = update(t, m, tea.KeyPressMsg{Code: tea.KeyEscape})
+	if m.currPage != registerPage {
+		t.Fatalf("esc twice: page = %v, want registerPage", m.currPage)
+	}
+
+	// Tab wraps around through the second state and back
+	m = update(t, m, tea.KeyPressMsg{Code: tea.KeyTab})
+	m = update(t, m, tea.KeyPressMsg{Code: tea.KeyTab})
+	if view := m.View().Content; !strings.Contains(view, "Register in Test State") {
+		t.Errorf("tab should wrap back to the first panel:\n%s", view)
+	}
+}
+
+func TestNoticeBannerDismissAndFullText(t *testing.T) {
+	m := withNotices()
+	m.currPage = contestsPage
+	if view := m.View().Content; !strings.Contains(view, "⚠ Richmond City") {
+		t.Fatalf("notice banner missing from the contests page:\n%s", view)
+	}
+
+	m = update(t, m, tea.KeyPressMsg{Code: 'n', Text: "n"})
+	view := m.View().Content
+	if m.currPage != noticePage || !strings.Contains(view, "this election only.") || !strings.Contains(view, "https://vote.example.gov/richmond/notice") {
+		t.Fatalf("n should show the full notices:\n%s", view)
+	}
+	m = update(t, m, tea.KeyPressMsg{Code: tea.KeyEscape})
+	if m.currPage != contestsPage {
+		t.Fatalf("esc: page = %v, want contestsPage", m.currPage)
+	}
+
+	height := m.contestsList.Height()
+	m = update(t, m, tea.KeyPressMsg{Code: 'x', Text: "x"})
+	if view := m.View().Content; strings.Contains(view, "⚠") {
+		t.Errorf("banner still shown after dismissing:\n%s", view)
+	}
+	if m.contestsList.Height() <= height {
+		t.Errorf("contests list height = %d after dismissing, want more than %d", m.contestsList.Height(), height)
+	}
+}
+
+// typeText sends each rune of s as a key press.
+func typeText(t *testing.T, m model, s string) model {
+	t.Helper()
+	for _, r := range s {
+		m = update(t, m, tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+	return m
+}
+
+func TestSearchGroupsResultsAndJumpsToThem(t *testing.T) {
+	raw := fixtureVoterInfo()
+	raw.Contests = append(raw.Contests, api.Contest{
+		ReferendumTitle: "Question 1",
+		ReferendumText:  "Shall the city issue bonds for a new library?",
+	})
+	raw.EarlyVoteSites = []api.PollingPlace{
+		{Name: "Old Library", StartDate: "2026-10-01", EndDate: "2026-10-10"},
+		{Name: "Main Library", Address: api.Address{Line1: "101 E Franklin St"}, StartDate: "2026-10-15", EndDate: "2026-10-31"},
+	}
+	m := newModel(80, 40)
+	m.now = fixtureNow
+	data, _ := domain.FromVoterInfo(raw)
+	m.electionData = &data
+	m = m.showResults()
+
+	m = update(t, m, tea.KeyPressMsg{Code: '/', Text: "/"})
+	if m.currPage != searchPage || m.searchInput.Value() != "" {
+		t.Fatalf("/ should open an empty search, got page %v with %q", m.currPage, m.searchInput.Value())
+	}
+
+	m = typeText(t, m, "library")
+	var groups []string
+	for _, r := range m.searchResults {
+		groups = append(groups, r.group+": "+r.title)
+	}
+	want := []string{"Ballot Measures: Question 1", "Early Voting Sites: Old Library", "Early Voting Sites: Main Library"}
+	if !slices.Equal(groups, want) {
+		t.Fatalf("results = %q, want %q", groups, want)
+	}
+	if view := m.View().Content; !strings.Contains(view, "Early Voting Sites (2)") {
+		t.Errorf("results not grouped under headings:\n%s", view)
+	}
+
+	// The closed site is shown once it's picked
+	m = update(t, m, tea.KeyPressMsg{Code: tea.KeyDown})
+	m = update(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.currPage != votePage || m.lm.ActiveList().Title != "Early Voting Sites" {
+		t.Fatalf("page = %v, list = %q; want the early voting list", m.currPage, m.lm.ActiveList().Title)
+	}
+	if place, ok := pollingPlaceItem(m.lm.SelectedItem()); !ok || place.Name != "Old Library" {
+		t.Errorf("selected %v, want Old Library", m.lm.SelectedItem())
+	}
+
+	m = update(t, m, tea.KeyPressMsg{Code: '/', Text: "/"})
+	m = typeText(t, m, "alex independent")
+	m = update(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if view := m.View().Content; m.currPage != contestContentPage || !strings.Contains(view, "Alex Doe") {
+		t.Errorf("a candidate should open their contest:\n%s", view)
+	}
+}
+
+func TestContestsGroupedByLevelAndDistrict(t *testing.T) {
+	m := withLongBallot()
+
+	var titles []string
+	for _, item := range m.contestsList.Items() {
+		titles = append(titles, item.(list.DefaultItem).Title())
+	}
+	want := []string{
+		"▾ Federal · Virginia", "U.S. Senate",
+		"▾ State · Virginia", "Governor",
+		"▾ City · Richmond City", "Mayor", "City Council",
+		"▾ Ballot Measures", "Question 1",
+	}
+	if !slices.Equal(titles, want) {
+		t.Fatalf("contests = %q, want %q", titles, want)
+	}
+
+	// Fold the city section from its header and the rest stay put
+	m.contestsList.Select(4)
+	m = update(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if n := len(m.contestsList.Items()); n != len(want)-2 {
+		t.Fatalf("%d items after folding, want %d", n, len(want)-2)
+	}
+	if s, ok := m.contestsList.SelectedItem().(contestSection); !ok || !s.collapsed {
+		t.Fatalf("selected %v after folding, want the folded section", m.contestsList.SelectedItem())
+	}
+
+	// Jumping to a contest in a folded section unfolds it
+	m = m.selectContest(4)
+	if c, ok := m.contestsList.SelectedItem().(contestItem); !ok || c.BallotTitle != "City Council" {
+		t.Errorf("selected %v, want City Council", m.contestsList.SelectedItem())
+	}
+}
+
+func TestBallotMeasuresOpenOnTheirOwnPage(t *testing.T) {
+	m := withLongBallot()
+	for n, item := range m.contestsList.Items() {
+		if c, ok := item.(contestItem); ok && c.Referendum != nil {
+			m.contestsList.Select(n)
+		}
+	}
+
+	m = update(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if view := m.View().Content; m.currPage != referendumPage || !strings.Contains(view, "Library bonds") {
+		t.Fatalf("enter on a measure: page = %v\n%s", m.currPage, view)
+	}
+	m = update(t, m, tea.KeyPressMsg{Code: tea.KeyEscape})
+	if m.currPage != contestsPage {
+		t.Errorf("esc: page = %v, want contestsPage", m.currPage)
+	}
+}
+
+func TestPartyBallotFilter(t *testing.T) {
+	m := withPrimary()
+	contests := func() []string {
+		var out []string
+		for _, item := range m.contestsList.Items() {
+			if c, ok := item.(contestItem); ok {
+				out = append(out, c.PrimaryParty+" "+c.BallotTitle)
+			}
+		}
+		return out
+	}
+	if got := contests(); len(got) != 4 {
+		t.Fatalf("contests = %q, want all four before choosing a party", got)
+	}
+
+	m = update(t, m, tea.KeyPressMsg{Code: 'p', Text: "p"})
+	want := []string{"Democratic Governor", "Democratic House of Delegates", " School Board"}
+	if got := contests(); m.primaryParty != "Democratic" || !slices.Equal(got, want) {
+		t.Fatalf("party %q: contests = %q, want %q", m.primaryParty, got, want)
+	}
+
+	m = update(t, m, tea.KeyPressMsg{Code: 'p', Text: "p"})
+	m = update(t, m, tea.KeyPressMsg{Code: 'p', Text: "p"})
+	if m.primaryParty != "" || len(contests()) != 4 {
+		t.Errorf("p should cycle back to every party, got %q with %q", m.primaryParty, contests())
+	}
+
+	// Jumping to another party's contest brings every party back
+	m = update(t, m, tea.KeyPressMsg{Code: 'p', Text: "p"})
+	m = m.selectContest(1)
+	if c, ok := m.contestsList.SelectedItem().(contestItem); m.primaryParty != "" || !ok || c.PrimaryParty != "Republican" {
+		t.Errorf("selected %v with party %q, want the Republican contest", m.contestsList.SelectedItem(), m.primaryParty)
+	}
+}
+
+func TestCompareMarkedCandidates(t *testing.T) {
+	m := withCandidates(80, 30)
+	m.compareMarks = nil
+
+	// One mark isn't enough to compare
+	m = update(t, m, tea.KeyPressMsg{Code: tea.KeySpace, Text: " "})
+	m = update(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.currPage != contestContentPage {
+		t.Fatalf("compared a single candidate: page = %v", m.currPage)
+	}
+
+	m = update(t, m, tea.KeyPressMsg{Code: tea.KeyDown})
+	m = update(t, m, tea.KeyPressMsg{Code: tea.KeySpace, Text: " "})
+	m = update(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	var names []string
+	for _, c := range m.markedCandidates() {
+		names = append(names, c.Name)
+	}
+	if m.currPage != comparePage || !slices.Equal(names, []string{"Alex Doe", "Sam Roe"}) {
+		t.Fatalf("page = %v comparing %q, want Alex Doe and Sam Roe", m.currPage, names)
+	}
+	if view := m.View().Content; !strings.Contains(view, "sam@roe.example.com") || !strings.Contains(view, "alexdoe") {
+		t.Errorf("compare page missing candidate details:\n%s", view)
+	}
+
+	m = update(t, m, tea.KeyPressMsg{Code: tea.KeyEscape})
+	if m.currPage != contestContentPage {
+		t.Errorf("esc: page = %v, want contestContentPage", m.currPage)
+	}
+}
+
+func TestParseSessionOptions(t *testing.T) {
+	tests := []struct {
+		environ, args []string
+		want          sessionOptions
+	}{
+		{nil, nil, sessionOptions{locale: i18n.English}},
+		{[]string{"LANG=es_US.UTF-8"}, nil, sessionOptions{locale: i18n.Spanish}},
+		{nil, []string{"--lang", "es"}, sessionOptions{locale: i18n.Spanish}},
+		{nil, []string{"-lang=es"}, sessionOptions{locale: i18n.Spanish}},
+		// An explicit argument beats the environment
+		{[]string{"LANG=es_US.UTF-8"}, []string{"--lang=en"}, sessionOptions{locale: i18n.English}},
+		// Unsupported languages and stray arguments are ignored
+		{[]string{"LANG=es_US.UTF-8"}, []string{"--lang=fr"}, sessionOptions{locale: i18n.Spanish}},
+		{[]string{"LANG=es_US.UTF-8"}, []string{"--bogus"}, sessionOptions{locale: i18n.Spanish}},
+		// Plain mode can be asked for by argument or environment
+		{nil, []string{"--plain", "--lang=es"}, sessionOptions{locale: i18n.Spanish, plain: true}},
+		{[]string{"ACCESSIBLE=1"}, nil, sessionOptions{locale: i18n.English, plain: true}},
+		{[]string{"ACCESSIBLE="}, nil, sessionOptions{locale: i18n.English}},
+		{[]string{"TERM=dumb"}, nil, sessionOptions{locale: i18n.English, plain: true}},
+		{[]string{"TERM=xterm-256color"}, nil, sessionOptions{locale: i18n.English}},
+		// So can a theme, with unknown ones left to the terminal
+		{nil, []string{"--theme", "high-contrast"}, sessionOptions{locale: i18n.English, theme: "high-contrast"}},
+		{[]string{"GOVOTE_THEME=colorblind"}, nil, sessionOptions{locale: i18n.English, theme: "colorblind"}},
+		{[]string{"GOVOTE_THEME=colorblind"}, []string{"--theme=light"}, sessionOptions{locale: i18n.English, theme: "light"}},
+		{nil, []string{"--theme=auto"}, sessionOptions{locale: i18n.English}},
+		{nil, []string{"--theme=sepia"}, sessionOptions{locale: i18n.English}},
+		// And key overrides, kept as given for each session to read
+		{nil, []string{"--keys", "vim"}, sessionOptions{locale: i18n.English, keys: "vim"}},
+		{[]string{"GOVOTE_KEYS=numbers"}, nil, sessionOptions{locale: i18n.English, keys: "numbers"}},
+		{[]string{"GOVOTE_KEYS=numbers"}, []string{"--keys=vim"}, sessionOptions{locale: i18n.English, keys: "vim"}},
+	}
+	for _, tt := range tests {
+		if got := parseSessionOptions(tt.environ, tt.args); got != tt.want {
+			t.Errorf("parseSessionOptions(%q, %q) = %+v, want %+v", tt.environ, tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestLanguageToggleKeepsPlace(t *testing.T) {
+	toggle := tea.KeyPressMsg{Code: 'l', Mod: tea.ModCtrl}
+
+	// What's typed in the form survives the switch
+	m := newModel(80, 24)
+	m.Init()
+	m = typeText(t, m, "1 Main St")
+	m = update(t, m, toggle)
+	if m.locale != i18n.Spanish {
+		t.Fatalf("locale = %q after ctrl+l, want Spanish", m.locale)
+	}
+	if view := m.View().Content; !strings.Contains(view, "Bienvenido") || !strings.Contains(view, "1 Main St") {
+		t.Errorf("form after switching language:\n%s", view)
+	}
+
+	// So does the selection in each list
+	m = withLongBallot()
+	m = m.selectContest(4)
+	m = update(t, m, toggle)
+	if m.contestsList.Title != "Contiendas" {
+		t.Errorf("contests list title = %q, want it in Spanish", m.contestsList.Title)
+	}
+	if c, ok := m.contestsList.SelectedItem().(contestItem); !ok || c.BallotTitle != "City Council" {
+		t.Errorf("selected %v after switching language, want City Council", m.contestsList.SelectedItem())
+	}
+	if s := m.contestsList.Items()[0].(contestSection); s.title != "Federal · Virginia" || s.Description() != "  1 contienda" {
+		t.Errorf("section = %q, %q", s.title, s.Description())
+	}
+
+	m = update(t, m, toggle)
+	if m.locale != i18n.English || m.contestsList.Title != "Contests" {
+		t.Errorf("ctrl+l twice: locale %q, title %q", m.locale, m.contestsList.Title)
+	}
+}
+
+// Every message the interface translates needs a Spanish translation.
+// Messages passed to the translation functions as literals are found in
+// the source; the rest are looked up by a value held elsewhere.
+func TestSpanishCatalogIsComplete(t *testing.T) {
+	messages := []string{
+		"%d contest", "%d contests", "%d measure", "%d measures",
+		"Election Info", "Registration URL", "Confirmation URL", "Absentee Voting Info",
+		"Location Finder", "Ballot Info", "Election Rules",
+		"Title", "Office Phone", "Fax", "Email",
+		"Absentee voting", "Ballot info",
+		"Your saved addresses have been deleted.",
+		"at least one address field is required",
+		"could not reach the election information service",
+		api.ErrNoElectionDay.Error(),
+	}
+	for l := domain.LevelUnknown; l <= domain.LevelSpecial; l++ {
+		messages = append(messages, l.String())
+	}
+	m := newVotePageModel(80, 24)
+	for _, l := range m.voteLists() {
+		messages = append(messages, l.title)
+	}
+	for _, f := range compareAddress(address.InputAddress{}, domain.Address{}) {
+		messages = append(messages, f.label)
+	}
+	for label := range compareFields(domain.Candidate{Party: "p", URL: "u", Email: "e", Phone: "p", OrderOnBallot: 1}) {
+		messages = append(messages, label)
+	}
+	km := list.DefaultKeyMap()
+	for _, b := range []key.Binding{km.CursorUp, km.CursorDown, km.PrevPage, km.NextPage, km.GoToStart, km.GoToEnd,
+		km.Filter, km.ClearFilter, km.CancelWhileFiltering, km.AcceptWhileFiltering, km.ShowFullHelp, km.CloseFullHelp, km.Quit} {
+		messages = append(messages, b.Help().Desc)
+	}
+	fkm := huh.NewDefaultKeyMap()
+	for _, b := range []key.Binding{fkm.Input.Prev, fkm.Input.Next, fkm.Input.Submit, fkm.Input.AcceptSuggestion,
+		fkm.Confirm.Prev, fkm.Confirm.Next, fkm.Confirm.Submit, fkm.Confirm.Toggle, fkm.Confirm.Accept, fkm.Confirm.Reject} {
+		messages = append(messages, b.Help().Desc)
+	}
+
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fset := token.NewFileSet()
+	for _, name := range files {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, name, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ast.Inspect(f, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) == 0 {
+				return true
+			}
+			if sel, ok := call.Fun.(*ast.SelectorExpr); !ok || !slices.Contains([]string{"t", "tf", "T", "Tf"}, sel.Sel.Name) {
+				return true
+			}
+			if lit, ok := call.Args[0].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+				msg, _ := strconv.Unquote(lit.Value)
+				messages = append(messages, msg)
+			}
+			return true
+		})
+	}
+
+	for _, msg := range messages {
+		if !i18n.Spanish.Has(msg) {
+			t.Errorf("no Spanish translation for %q", msg)
+		}
+	}
+}
+
+// isBoxDrawing reports whether r is one of the characters terminal programs
+// draw borders and tables with, which screen readers read out one by one.
+func isBoxDrawing(r rune) bool {
+	return r >= 0x2500 && r <= 0x259F
+}
+
+func TestPlainModeIsLinearText(t *testing.T) {
+	withPage := func(m model, p page) model {
+		m.currPage = p
+		return m
+	}
+	marked := withCandidates(80, 30)
+	marked.compareMarks[1] = true
+	confirm := newModel(80, 24)
+	confirm.input = address.InputAddress{Street: "100 main", PostalCode: "23220"}
+	confirm.electionData = &domain.VoterInfo{NormalizedInput: domain.Address{Line1: "100 Main St", City: "Richmond", State: "VA", Zip: "23220"}}
+	confirm.currPage = confirmAddressPage
+
+	pages := map[string]model{
+		"input":         newModel(80, 24),
+		"confirm":       confirm,
+		"vote":          withNotices(),
+		"notices":       withNotices().showNotices(),
+		"polling place": withPage(newVotePageModel(80, 24), pollingPlacePage),
+		"contests":      withLongBallot(),
+		"contest":       withCandidates(80, 30),
+		"compare":       withPage(marked, comparePage),
+		"measure":       withReferendum(80, 40),
+		"register":      withPage(newVotePageModel(80, 24), registerPage),
+		"timeline":      withPage(newVotePageModel(80, 24), timelinePage),
+		"no contests":   withPage(newVotePageModel(80, 24).withPlain(true), contestContentPage),
+	}
+	for name, m := range pages {
+		m, _ = m.togglePlain()
+		if !m.plain {
+			m, _ = m.togglePlain()
+		}
+		view := m.View()
+		if view.AltScreen {
+			t.Errorf("%s: plain mode uses the alternate screen", name)
+		}
+		if i := strings.IndexFunc(view.Content, isBoxDrawing); i >= 0 {
+			t.Errorf("%s: plain mode draws %q:\n%s", name, []rune(view.Content[i:])[0], view.Content)
+		}
+	}
+}
+
+func TestPlainModeNumbersMenusAndItems(t *testing.T) {
+	toggle := tea.KeyPressMsg{Code: 'r', Mod: tea.ModCtrl}
+	m := withLongBallot()
+	m = m.selectContest(4)
+	m = update(t, m, toggle)
+	if !m.plain {
+		t.Fatal("ctrl+r didn't turn on plain mode")
+	}
+	if c, ok := m.contestsList.SelectedItem().(contestItem); !ok || c.BallotTitle != "City Council" {
+		t.Errorf("selected %v after switching to plain mode, want City Council", m.contestsList.SelectedItem())
+	}
+
+	view := ansi.Strip(m.View().Content)
+	for _, want := range []string{"govote.sh: Contests", "[1] Vote, [2] Contests, [3] Register, [4] Timeline", "> 7. City Council", "  1. Federal · Virginia"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("plain contests page missing %q:\n%s", want, view)
+		}
+	}
+	m = update(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	m = update(t, m, tea.KeyPressMsg{Code: tea.KeyEscape})
+	m = m.toggleSection(m.contestsList.Items()[0].(contestSection))
+	if view := ansi.Strip(m.View().Content); !strings.Contains(view, "> 1. Federal · Virginia (folded)") {
+		t.Errorf("folded section should say so:\n%s", view)
+	}
+
+	m = update(t, m, tea.KeyPressMsg{Code: '3', Text: "3"})
+	if m.currPage != registerPage {
+		t.Errorf("3: page = %v, want registerPage", m.currPage)
+	}
+	m = update(t, m, tea.KeyPressMsg{Code: '9', Text: "9"})
+	if m.currPage != registerPage {
+		t.Errorf("9 is past the menu, but page = %v", m.currPage)
+	}
+
+	m = update(t, m, toggle)
+	if m.plain || !m.View().AltScreen {
+		t.Error("ctrl+r twice should go back to full screen")
+	}
+	m = update(t, m, tea.KeyPressMsg{Code: '1', Text: "1"})
+	if m.currPage != registerPage {
+		t.Errorf("numbers only open pages in plain mode, but page = %v", m.currPage)
+	}
+}
+
+func TestAutoTheme(t *testing.T) {
+	tests := []struct {
+		profile colorprofile.Profile
+		dark    bool
+		want    string
+	}{
+		{colorprofile.TrueColor, true, "default"},
+		{colorprofile.ANSI256, true, "default"},
+		{colorprofile.ANSI256, false, "light"},
+		{colorprofile.ANSI, true, "high-contrast"},
+		{colorprofile.ANSI, false, "high-contrast"},
+		{colorprofile.ASCII, true, "high-contrast"},
+		// Before the terminal says
+		{colorprofile.Unknown, true, "default"},
+	}
+	for _, tt := range tests {
+		if got := autoTheme(tt.profile, tt.dark); got.Name != tt.want {
+			t.Errorf("autoTheme(%v, dark %v) = %q, want %q", tt.profile, tt.dark, got.Name, tt.want)
+		}
+	}
+}
+
+func TestThemeFollowsTerminal(t *testing.T) {
+	m := newVotePageModel(80, 24).withTheme("")
+	m = update(t, m, tea.BackgroundColorMsg{Color: lipgloss.Color("#ffffff")})
+	if m.theme.Name != "light" {
+		t.Errorf("theme = %q on a light background, want light", m.theme.Name)
+	}
+	m = update(t, m, tea.ColorProfileMsg{Profile: colorprofile.ANSI})
+	if m.theme.Name != "high-contrast" {
+		t.Errorf("theme = %q with 16 colors, want high-contrast", m.theme.Name)
+	}
+
+	// A theme the user picked stays put
+	m = newVotePageModel(80, 24).withTheme("colorblind")
+	m = update(t, m, tea.BackgroundColorMsg{Color: lipgloss.Color("#ffffff")})
+	m = update(t, m, tea.ColorProfileMsg{Profile: colorprofile.ANSI})
+	if m.theme.Name != "colorblind" {
+		t.Errorf("theme = %q after the terminal reported its colors, want colorblind", m.theme.Name)
+	}
+}
+
+func TestThemeKeyCyclesThemes(t *testing.T) {
+	toggle := tea.KeyPressMsg{Code: 't', Mod: tea.ModCtrl}
+	m := withLongBallot().withTheme("")
+	m = m.selectContest(4)
+
+	var names []string
+	for range len(themes) + 1 {
+		m = update(t, m, toggle)
+		names = append(names, m.themeName)
+	}
+	if want := []string{"default", "high-contrast", "light", "colorblind", "auto"}; !slices.Equal(names, want) {
+		t.Errorf("ctrl+t went through %q, want %q", names, want)
+	}
+	if m.currPage != contestsPage {
+		t.Errorf("page = %v after switching themes, want contestsPage", m.currPage)
+	}
+	if c, ok := m.contestsList.SelectedItem().(contestItem); !ok || c.BallotTitle != "City Council" {
+		t.Errorf("selected %v after switching themes, want City Council", m.contestsList.SelectedItem())
+	}
+}
+
+func TestHelpFooterFollowsThePage(t *testing.T) {
+	m := withCandidates(80, 30)
+	m.compareMarks = nil
+	if footer := m.helpFooter(); !strings.Contains(footer, "mark to compare") || strings.Contains(footer, "election officials") {
+		t.Errorf("contest page footer = %q", footer)
+	}
+
+	m = update(t, m, tea.KeyPressMsg{Code: tea.KeySpace, Text: " "})
+	if footer := m.helpFooter(); !strings.Contains(footer, "(1 marked)") {
+		t.Errorf("footer after marking = %q, want the marked count", footer)
+	}
+}
+
+func TestHelpOverlayListsEveryKey(t *testing.T) {
+	m := newVotePageModel(80, 40)
+	m = update(t, m, tea.KeyPressMsg{Code: '?', Text: "?"})
+	if !m.help.ShowAll {
+		t.Fatal("? did not open the full help")
+	}
+	view := m.View().Content
+	for _, want := range []string{"This page", "Pages", "Anywhere", "next list", "contests", "Español"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("full help is missing %q:\n%s", want, view)
+		}
+	}
+
+	// Keys for the page underneath do nothing while the help is open
+	m = update(t, m, tea.KeyPressMsg{Code: 'c', Text: "c"})
+	if m.currPage != votePage || !m.help.ShowAll {
+		t.Errorf("page = %v, help open = %v after c, want the help still over the vote page", m.currPage, m.help.ShowAll)
+	}
+
+	m = update(t, m, tea.KeyPressMsg{Code: tea.KeyEscape})
+	if m.help.ShowAll || m.currPage != votePage {
+		t.Errorf("page = %v, help open = %v after esc, want the vote page", m.currPage, m.help.ShowAll)
+	}
+}
+
+func TestParseKeyOverrides(t *testing.T) {
+	overrides, err := parseKeyOverrides("vim, contests=c 2,sepia,bogus=x,quit=")
+	if err == nil {
+		t.Error("no error for an unknown preset, an unknown action and an empty binding")
+	}
+	keys := newKeyMap(i18n.English, overrides)
+	if got := keys.NextTab.Keys(); !slices.Equal(got, []string{"L"}) {
+		t.Errorf("next tab keys = %q, want L", got)
+	}
+	if got := keys.Contests.Help().Key; got != "c/2" {
+		t.Errorf("contests help = %q, want c/2", got)
+	}
+	if got := keys.Quit.Keys(); !slices.Equal(got, []string{"q", "Q"}) {
+		t.Errorf("quit keys = %q, want the defaults", got)
+	}
+	if conflicts := newKeyMap(i18n.English, []keyOverride{{"vote", []string{"c"}}}).conflicts(); !slices.Equal(conflicts, []string{"c"}) {
+		t.Errorf("conflicts = %q, want c", conflicts)
+	}
+}
+
+func TestKeyOverridesSwitchTabs(t *testing.T) {
+	m := newVotePageModel(80, 24).withKeys("numbers,vim")
+	m = update(t, m, tea.KeyPressMsg{Code: '2', Text: "2"})
+	if m.currPage != contestsPage {
+		t.Errorf("page = %v after 2, want contestsPage", m.currPage)
+	}
+	m = update(t, m, tea.KeyPressMsg{Code: 'L', Text: "L", Mod: tea.ModShift})
+	if m.currPage != registerPage {
+		t.Errorf("page = %v after L, want registerPage", m.currPage)
+	}
+	m = update(t, m, tea.KeyPressMsg{Code: 'H', Text: "H", Mod: tea.ModShift})
+	m = update(t, m, tea.KeyPressMsg{Code: 'H', Text: "H", Mod: tea.ModShift})
+	if m.currPage != votePage {
+		t.Errorf("page = %v after H twice, want votePage", m.currPage)
+	}
+
+	if view := ansi.Strip(m.View().Content); !strings.Contains(view, "[V/1] Vote") || !strings.Contains(view, "[C/2] Contests") {
+		t.Errorf("header doesn't name the rebound keys:\n%s", view)
+	}
+
+	// Overrides outlast a change of language
+	m, _ = m.toggleLocale()
+	if !slices.Equal(m.keys.NextTab.Keys(), []string{"L"}) {
+		t.Errorf("next tab keys = %q after switching languages, want L", m.keys.NextTab.Keys())
+	}
+}
+
+func TestPageKeysBeatTheHeader(t *testing.T) {
+	data := fixtureVoterInfo()
+	data.EarlyVoteSites = []api.PollingPlace{
+		{Name: "Library", StartDate: "2026-10-01", EndDate: "2026-10-10"},
+		{Name: "City Hall", StartDate: "2026-10-15", EndDate: "2026-10-31"},
+	}
+	m := newModel(80, 40).withKeys("contests=e")
+	m.now = fixtureNow
+	m = update(t, m, tea.WindowSizeMsg{Width: 80, Height: 40})
+	m.currPage = loadingPage
+	m = update(t, m, data)
+
+	m = update(t, m, tea.KeyPressMsg{Code: 'e', Text: "e"})
+	if m.currPage != votePage || !m.showExpired {
+		t.Errorf("page = %v, showing closed = %v after e, want the vote page's own e", m.currPage, m.showExpired)
+	}
+
+	// Letters typed into the search are the search's, even rebound ones
+	m = update(t, m, tea.KeyPressMsg{Code: '/', Text: "/"})
+	m = typeText(t, m, "eq")
+	if m.currPage != searchPage {
+		t.Errorf("page = %v after typing a search, want searchPage", m.currPage)
+	}
+
+	// ctrl+c quits from anywhere
+	if _, cmd := m.Update(tea.KeyPressMsg{Code: 'c', Mod: tea.ModCtrl}); cmd == nil || cmd() != tea.Quit() {
+		t.Error("ctrl+c on the search page did not quit")
+	}
+}
+
+// click clicks the first place s is drawn on the page.
+func click(t *testing.T, m model, s string) model {
+	t.Helper()
+	m, _ = clickCmd(t, m, s)
+	return m
+}
+
+// clickCmd is click, also returning what the click asked of the program.
+func clickCmd(t *testing.T, m model, s string) (model, tea.Cmd) {
+	t.Helper()
+	for y, line := range strings.Split(ansi.Strip(m.View().Content), "\n") {
+		if i := strings.Index(line, s); i >= 0 {
+			next, cmd := m.Update(tea.MouseClickMsg{X: ansi.StringWidth(line[:i]), Y: y, Button: tea.MouseLeft})
+			return next.(model), cmd
+		}
+	}
+	t.Fatalf("%q is not on the page:\n%s", s, m.View().Content)
+	return m, nil
+}
+
+func TestClickHeaderTabs(t *testing.T) {
+	m := newVotePageModel(80, 24)
+	if mode := m.View().MouseMode; mode != tea.MouseModeCellMotion {
+		t.Errorf("mouse mode = %v, want cell motion", mode)
+	}
+	// The vote page's only site is already selected, so a click opens it
+	if opened := click(t, m, "Main St Community Center,"); opened.currPage != pollingPlacePage {
+		t.Errorf("page = %v after clicking the site, want pollingPlacePage", opened.currPage)
+	}
+	m = click(t, m, "Contests")
+	if m.currPage != contestsPage {
+		t.Fatalf("page = %v after clicking Contests, want contestsPage", m.currPage)
+	}
+
+	m.currPage = pollingPlacePage
+	m = click(t, m, "Back")
+	if m.currPage != votePage {
+		t.Errorf("page = %v after clicking Back, want votePage", m.currPage)
+	}
+
+	// A click just left of a tab's border is still on the tab before, on
+	// every page with tabs
+	for _, page := range []page{votePage, contestsPage, registerPage, timelinePage} {
+		m := newVotePageModel(80, 24)
+		m.currPage = page
+		lines := strings.Split(ansi.Strip(m.View().Content), "\n")
+		row := lines[2]
+		border := strings.LastIndex(row[:strings.Index(row, "Contests")], "│")
+		next, _ := m.Update(tea.MouseClickMsg{X: ansi.StringWidth(row[:border]) - 1, Y: 2, Button: tea.MouseLeft})
+		if got := next.(model).currPage; got != votePage {
+			t.Errorf("clicking left of the Contests tab on %v opened %v, want votePage", page, got)
+		}
+	}
+
+	// Plain mode leaves the mouse to the terminal
+	if mode := newVotePageModel(80, 24).withPlain(true).View().MouseMode; mode != tea.MouseModeNone {
+		t.Errorf("mouse mode = %v in plain mode, want none", mode)
+	}
+}
+
+func TestClickAndScrollLists(t *testing.T) {
+	m := withLongBallot()
+	m = click(t, m, "City Council")
+	if c, ok := m.contestsList.SelectedItem().(contestItem); !ok || c.BallotTitle != "City Council" {
+		t.Fatalf("selected %v after clicking City Council", m.contestsList.SelectedItem())
+	}
+
+	m = update(t, m, tea.MouseWheelMsg{Button: tea.MouseWheelUp})
+	if c, ok := m.contestsList.SelectedItem().(contestItem); !ok || c.BallotTitle != "Mayor" {
+		t.Errorf("selected %v after scrolling up, want Mayor", m.contestsList.SelectedItem())
+	}
+
+	// A second click opens what the first selected
+	m = click(t, m, "Mayor")
+	m = click(t, m, "Mayor")
+	if m.currPage != contestContentPage {
+		t.Errorf("page = %v after clicking Mayor twice, want contestContentPage", m.currPage)
+	}
+}
+
+func TestClickCopiesWebAddresses(t *testing.T) {
+	m := newVotePageModel(80, 24)
+	m.currPage = registerPage
+	m, cmd := clickCmd(t, m, "vote.example.gov/register")
+	if cmd == nil {
+		t.Error("clicking a web address did not copy it")
+	}
+	if m.copied != "https://vote.example.gov/register" {
+		t.Errorf("copied %q, want the registration address", m.copied)
+	}
+	if footer := ansi.Strip(m.helpFooter()); !strings.Contains(footer, "Copied https://vote.example.gov/register") {
+		t.Errorf("footer = %q, want what was copied", footer)
+	}
+	if m = update(t, m, tea.KeyPressMsg{Code: tea.KeyDown}); m.copied != "" {
+		t.Error("the copied address outlasted a key press")
+	}
+}
+
+func TestClickCopiesWrappedWebAddresses(t *testing.T) {
+	m := withCandidates(50, 30)
+	m.currPage = comparePage
+	// The website column is too narrow for the address, which wraps after
+	// "example"; either piece copies all of it
+	for _, piece := range []string{"https://alexdoe", ".com"} {
+		if clicked := click(t, m, piece); clicked.copied != "https://alexdoe.example.com" {
+			t.Errorf("clicking %q copied %q, want the whole address", piece, clicked.copied)
+		}
+	}
+}
+
+func TestKeyOverridesReachTheAddressPages(t *testing.T) {
+	data := fixtureVoterInfo()
+	data.NormalizedInput = api.Address{Line1: "1234 W Broad St", City: "Richmond", State: "VA", Zip: "23220"}
+	m := lookupMsgModel(address.InputAddress{Street: "1234 broad", City: "Richmond", State: "VA"}).withKeys("quit=ctrl+q,reject=b")
+	m = update(t, m, data)
+
+	if _, cmd := m.Update(tea.KeyPressMsg{Code: 'q', Text: "q"}); cmd != nil {
+		t.Error("q still quits after quit was rebound")
+	}
+	if _, cmd := m.Update(tea.KeyPressMsg{Code: 'q', Mod: tea.ModCtrl}); cmd == nil || cmd() != tea.Quit() {
+		t.Error("ctrl+q does not quit after quit was rebound to it")
+	}
+	if edited := update(t, m, tea.KeyPressMsg{Code: 'b', Text: "b"}); edited.currPage != inputPage {
+		t.Errorf("page = %v after the rebound edit key, want inputPage", edited.currPage)
+	}
+}
+
+func TestAddressPagesHaveHelp(t *testing.T) {
+	data := fixtureVoterInfo()
+	data.NormalizedInput = api.Address{Line1: "1234 W Broad St", City: "Richmond", State: "VA", Zip: "23220"}
+	m := update(t, lookupMsgModel(address.InputAddress{Street: "1234 broad", City: "Richmond", State: "VA"}), data)
+	if m.currPage != confirmAddressPage {
+		t.Fatalf("currPage = %v, want confirmAddressPage", m.currPage)
+	}
+
+	m = update(t, m, tea.KeyPressMsg{Code: '?', Text: "?"})
+	if !m.help.ShowAll || !strings.Contains(ansi.Strip(m.View().Content), "edit address") {
+		t.Error("? does not open the full help on the confirm page")
+	}
+	if m = update(t, m, tea.KeyPressMsg{Code: tea.KeyEscape}); m.help.ShowAll || m.currPage != confirmAddressPage {
+		t.Errorf("esc in the help: ShowAll = %v, currPage = %v; want the confirm page back", m.help.ShowAll, m.currPage)
+	}
+
+	st := newTestStore(t)
+	if _, err := st.Save(testUserID, "home", address.InputAddress{City: "Richmond"}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	saved := newModel(80, 24).withStore(st, testUserID)
+	if view := ansi.Strip(saved.View().Content); !strings.Contains(view, "enter look up") {
+		t.Errorf("saved address page has no key footer:\n%s", view)
+	}
+}
@@ -0,0 +1,336 @@
+package tui
+
+import (
+	"cmp"
+	"regexp"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/list"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/govote-sh/govote/internal/domain"
+)
+
+// mouseMode is the view's mouse mode: clicks and the wheel, except in plain
+// mode, which leaves the mouse to the terminal so text can be selected and
+// read as usual.
+func (m model) mouseMode() tea.MouseMode {
+	if m.plain {
+		return tea.MouseModeNone
+	}
+	return tea.MouseModeCellMotion
+}
+
+// updateMouse handles clicks and the wheel on the results pages. A click
+// on a header tab opens it, one on a web address copies it, and one on a
+// list item selects it, or opens it if it was selected already. The wheel
+// moves through a page's list, candidates or compared columns; other pages
+// scroll their viewport.
+func (m model) updateMouse(msg tea.Msg) (tea.Model, tea.Cmd, bool) {
+	if !m.hasMenu || m.help.ShowAll {
+		return m, nil, true
+	}
+	switch msg := msg.(type) {
+	case tea.MouseClickMsg:
+		mouse := msg.Mouse()
+		if mouse.Button != tea.MouseLeft {
+			return m, nil, true
+		}
+		m.copied = ""
+		if mouse.Y >= 1 && mouse.Y <= lipgloss.Height(m.tabBar()) {
+			return m.clickTab(mouse.X), nil, true
+		}
+		if url := urlAt(m.viewPage(), m.webAddresses(), mouse.X, mouse.Y); url != "" {
+			m.copied = url
+			return m, tea.SetClipboard(url), true
+		}
+		if l, top, d := m.pageList(); l != nil {
+			i := itemAt(*l, d, mouse.Y-top)
+			switch {
+			case i < 0:
+			case i == l.Index():
+				next, cmd := m.openSelected()
+				return next, cmd, true
+			default:
+				return m.selectItem(i), nil, true
+			}
+		}
+		return m, nil, true
+	case tea.MouseWheelMsg:
+		step := 0
+		switch msg.Mouse().Button {
+		case tea.MouseWheelUp:
+			step = -1
+		case tea.MouseWheelDown:
+			step = 1
+		}
+		if l, _, _ := m.pageList(); l != nil {
+			if n := len(l.VisibleItems()); n > 0 {
+				return m.selectItem(max(0, min(n-1, l.Index()+step))), nil, true
+			}
+			return m, nil, true
+		}
+		switch m.currPage {
+		case contestContentPage:
+			if contest, ok := m.selectedContest(); ok && len(contest.Candidates) > 0 {
+				m.candidateCursor = max(0, min(len(contest.Candidates)-1, m.candidateCursor+step))
+			}
+			return m, nil, true
+		case comparePage:
+			n := len(m.markedCandidates())
+			count, _ := m.compareColumns(n)
+			m.compareOffset = max(0, min(n-count, m.compareOffset+step))
+			return m, nil, true
+		case searchPage:
+			m.searchCursor = max(0, min(len(m.searchResults)-1, m.searchCursor+step))
+			return m, nil, true
+		}
+	}
+	// Left to the page's viewport
+	return m, nil, false
+}
+
+// clickTab acts on the header tab at column x: opens it, or on a page opened
+// from another, goes back.
+func (m model) clickTab(x int) model {
+	rows := strings.Split(ansi.Strip(m.tabBar()), "\n")
+	if len(rows) < 2 {
+		return m
+	}
+	// Count the borders left of x to find its cell. The first is the page's
+	// margin away from the screen's edge, and the first cell is the title.
+	cell, col := 0, 1
+	for _, r := range rows[1] {
+		if col > x {
+			break
+		}
+		if r == '│' {
+			cell++
+		}
+		col += ansi.StringWidth(string(r))
+	}
+	switch {
+	case cell < 2:
+		return m
+	case m.subpage():
+		m.currPage = m.parentPage()
+		return m
+	default:
+		return m.openMenuPage(cell - 1)
+	}
+}
+
+// webAddress matches a web address in drawn text.
+var webAddress = regexp.MustCompile(`https?://[^\s│]+`)
+
+// webAddresses are the web addresses in the election data, longest first
+// so that one beginning another isn't taken for it.
+func (m model) webAddresses() []string {
+	if m.electionData == nil {
+		return nil
+	}
+	var urls []string
+	add := func(admin domain.Administration) {
+		urls = append(urls, admin.ElectionInfoURL, admin.RegistrationURL, admin.RegistrationConfirmationURL,
+			admin.NoticeURL, admin.AbsenteeVotingInfoURL, admin.VotingLocationFinderURL, admin.BallotInfoURL,
+			admin.ElectionRulesURL)
+	}
+	for _, state := range m.electionData.States {
+		add(state.Administration)
+		if state.LocalJurisdiction != nil {
+			add(state.LocalJurisdiction.Administration)
+		}
+	}
+	for _, contest := range m.electionData.Contests {
+		for _, c := range contest.Candidates {
+			urls = append(urls, c.URL)
+		}
+		if contest.Referendum != nil {
+			urls = append(urls, contest.Referendum.URL)
+		}
+	}
+	for _, sites := range [][]domain.PollingPlace{m.electionData.PollingLocations, m.electionData.EarlyVoteSites, m.electionData.DropOffLocations} {
+		for _, p := range sites {
+			if url, err := p.GetMapsUrl(); err == nil {
+				urls = append(urls, url)
+			}
+		}
+	}
+	urls = slices.DeleteFunc(urls, func(url string) bool { return url == "" })
+	slices.SortFunc(urls, func(a, b string) int { return cmp.Or(len(b)-len(a), strings.Compare(a, b)) })
+	return slices.Compact(urls)
+}
+
+// urlAt is the web address drawn at column x of line y of view, if any.
+// Addresses from urls are found even when wrapped over several lines, as
+// in a narrow column; others only when drawn on one.
+func urlAt(view string, urls []string, x, y int) string {
+	lines := strings.Split(ansi.Strip(view), "\n")
+	if y < 0 || y >= len(lines) {
+		return ""
+	}
+	for _, url := range urls {
+		if urlDrawnAt(lines, url, x, y) {
+			return url
+		}
+	}
+	line := lines[y]
+	for _, loc := range webAddress.FindAllStringIndex(line, -1) {
+		url := strings.TrimRight(line[loc[0]:loc[1]], ".,;:)")
+		start := ansi.StringWidth(line[:loc[0]])
+		if x >= start && x < start+ansi.StringWidth(url) {
+			return url
+		}
+	}
+	return ""
+}
+
+// urlDrawnAt reports whether url is drawn over column x of line y. A
+// wrapped address goes on with a word on each following line, at or left of
+// the column it began in.
+func urlDrawnAt(lines []string, url string, x, y int) bool {
+	type span struct{ line, start, end int }
+	for i := 0; i <= y; i++ {
+		for _, loc := range drawnWord.FindAllStringIndex(lines[i], -1) {
+			word := lines[i][loc[0]:loc[1]]
+			j := strings.Index(word, "http")
+			if j < 0 {
+				continue
+			}
+			word = word[j:]
+			col := ansi.StringWidth(lines[i][:loc[0]+j])
+			spans := []span{{i, col, col + ansi.StringWidth(word)}}
+			rest, ok := strings.CutPrefix(url, word)
+			if strings.HasPrefix(word, url) {
+				spans[0].end = col + ansi.StringWidth(url)
+				rest, ok = "", true
+			}
+			for l := i + 1; ok && rest != "" && l < len(lines); l++ {
+				ok = false
+				words := drawnWord.FindAllStringIndex(lines[l], -1)
+				for _, loc := range slices.Backward(words) {
+					start := ansi.StringWidth(lines[l][:loc[0]])
+					next := lines[l][loc[0]:loc[1]]
+					if start > col || !strings.HasPrefix(rest, next) && !strings.HasPrefix(next, rest) {
+						continue
+					}
+					width := ansi.StringWidth(next)
+					if strings.HasPrefix(next, rest) {
+						width = ansi.StringWidth(rest)
+						rest = ""
+					} else {
+						rest = rest[len(next):]
+					}
+					spans = append(spans, span{l, start, start + width})
+					ok = true
+					break
+				}
+			}
+			if !ok || rest != "" {
+				continue
+			}
+			for _, s := range spans {
+				if s.line == y && x >= s.start && x < s.end {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// drawnWord matches a run of drawn text between spaces and borders.
+var drawnWord = regexp.MustCompile(`[^\s│]+`)
+
+// pageList is the current page's list, the screen line it starts on and
+// the delegate drawing its items, or nil on pages without a list.
+func (m model) pageList() (*list.Model, int, list.ItemDelegate) {
+	switch m.currPage {
+	case votePage:
+		if m.lm == nil {
+			return nil, 0, nil
+		}
+		l := m.lm.ActiveList()
+		return &l, 1 + lipgloss.Height(m.voteHeading()), m.newSiteDelegate()
+	case contestsPage:
+		if m.contestsList == nil || len(m.electionData.Contests) == 0 {
+			return nil, 0, nil
+		}
+		return m.contestsList, 1 + lipgloss.Height(m.contestsHeading()), m.newContestDelegate()
+	case timelinePage:
+		if m.timelineList == nil {
+			return nil, 0, nil
+		}
+		return m.timelineList, 1 + lipgloss.Height(m.HeaderView()), newDimmingDelegate(m.theme)
+	case officialsPage:
+		if m.officialsList == nil {
+			return nil, 0, nil
+		}
+		return m.officialsList, 1 + lipgloss.Height(m.HeaderView()), m.theme.defaultDelegate()
+	}
+	return nil, 0, nil
+}
+
+// itemAt is the index of l's item drawn y lines below its top, or -1 if
+// there's none there. Items start under the list's title and status bar.
+func itemAt(l list.Model, d list.ItemDelegate, y int) int {
+	if l.ShowTitle() {
+		y -= lipgloss.Height(l.Styles.TitleBar.Render(l.Title))
+	}
+	if l.ShowStatusBar() {
+		y -= lipgloss.Height(l.Styles.StatusBar.Render(""))
+	}
+	stride := d.Height() + d.Spacing()
+	if y < 0 || stride == 0 || y%stride >= d.Height() {
+		return -1
+	}
+	start, end := l.Paginator.GetSliceBounds(len(l.VisibleItems()))
+	if i := start + y/stride; i < end {
+		return i
+	}
+	return -1
+}
+
+// selectItem selects the current page's list item i.
+func (m model) selectItem(i int) model {
+	selectIn := func(l *list.Model) *list.Model {
+		next := *l
+		next.Select(i)
+		return &next
+	}
+	switch m.currPage {
+	case votePage:
+		m.lm.Select(i)
+	case contestsPage:
+		m.contestsList = selectIn(m.contestsList)
+	case timelinePage:
+		m.timelineList = selectIn(m.timelineList)
+	case officialsPage:
+		m.officialsList = selectIn(m.officialsList)
+	}
+	return m
+}
+
+// openSelected does what enter does to the current page's selected item.
+func (m model) openSelected() (tea.Model, tea.Cmd) {
+	switch m.currPage {
+	case votePage:
+		if _, ok := pollingPlaceItem(m.lm.SelectedItem()); ok {
+			m.currPage = pollingPlacePage
+		}
+	case contestsPage:
+		switch item := m.contestsList.SelectedItem().(type) {
+		case contestSection:
+			return m.toggleSection(item), nil
+		case contestItem:
+			return m.openContest(), nil
+		}
+	case officialsPage:
+		if _, ok := m.officialsList.SelectedItem().(officialItem); ok {
+			m.currPage = officialPage
+		}
+	}
+	return m, nil
+}
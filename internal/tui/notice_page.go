@@ -0,0 +1,161 @@
+package tui
+
+import (
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/govote-sh/govote/internal/utils"
+)
+
+// electionNotice is an urgent message from an election office, such as a
+// moved polling place or an extended deadline.
+type electionNotice struct {
+	from  string // The office's state or jurisdiction, e.g. "Richmond City"
+	local bool
+	text  string
+	url   string
+}
+
+// noticeStyle color-codes a notice by who sent it.
+func (m model) noticeStyle(n electionNotice) lipgloss.Style {
+	if n.local {
+		return lipgloss.NewStyle().Bold(true).Foreground(m.theme.Alert)
+	}
+	return lipgloss.NewStyle().Bold(true).Foreground(m.theme.Warning)
+}
+
+// electionNotices collects every state and local notice, each state's
+// before its local one.
+func (m model) electionNotices() []electionNotice {
+	if m.electionData == nil {
+		return nil
+	}
+	var notices []electionNotice
+	for _, state := range m.electionData.States {
+		if admin := state.Administration; admin.NoticeText != "" || admin.NoticeURL != "" {
+			notices = append(notices, electionNotice{from: state.Name, text: admin.NoticeText, url: admin.NoticeURL})
+		}
+		if local := state.LocalJurisdiction; local != nil {
+			if admin := local.Administration; admin.NoticeText != "" || admin.NoticeURL != "" {
+				notices = append(notices, electionNotice{from: local.Name, local: true, text: admin.NoticeText, url: admin.NoticeURL})
+			}
+		}
+	}
+	return notices
+}
+
+// noticeBanner is a one-line summary of each notice, shown under the header
+// until dismissed.
+func (m model) noticeBanner() string {
+	notices := m.electionNotices()
+	if m.noticesDismissed || len(notices) == 0 {
+		return ""
+	}
+
+	// Leave room for the border, padding and page margins
+	width := m.width - 6
+	keyStyle := lipgloss.NewStyle().Foreground(m.theme.Accent).Render
+	hintStyle := lipgloss.NewStyle().Foreground(m.theme.Muted).Render
+
+	var lines []string
+	for _, n := range notices {
+		summary := n.text
+		if summary == "" {
+			summary = n.url
+		}
+		summary, _, _ = strings.Cut(summary, "\n")
+		tag := "⚠ " + n.from + ": "
+		if m.plain {
+			// A screen reader can't see what was cut off, so wrap instead
+			lines = append(lines, utils.Wrap(m.noticeStyle(n).Render(tag)+summary, m.width-2))
+			continue
+		}
+		// EllipticalTruncate's "..." comes on top of its limit
+		lines = append(lines, m.noticeStyle(n).Render(tag)+utils.EllipticalTruncate(summary, max(1, width-lipgloss.Width(tag)-3)))
+	}
+	lines = append(lines, keyStyle(keyLabel(m.keys.Notices))+hintStyle(" "+m.t("Read full notice")+" · ")+keyStyle(keyLabel(m.keys.Dismiss))+hintStyle(" "+m.t("Dismiss")))
+
+	if m.plain {
+		return strings.Join(lines, "\n")
+	}
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.Alert).
+		Padding(0, 1).
+		Width(m.width - 2).
+		Render(strings.Join(lines, "\n"))
+}
+
+// bannerHeight is how much room the notice banner takes on results pages.
+func (m model) bannerHeight() int {
+	if banner := m.noticeBanner(); banner != "" {
+		return lipgloss.Height(banner)
+	}
+	return 0
+}
+
+// dismissNotices hides the banner for these results. The full notices stay
+// a keypress away.
+func (m model) dismissNotices() model {
+	m.noticesDismissed = true
+	return m.resizeLists()
+}
+
+// showNotices opens the full text of every notice, returning to the current
+// page on esc.
+func (m model) showNotices() model {
+	if len(m.electionNotices()) == 0 {
+		return m
+	}
+	if m.currPage != noticePage {
+		m.noticeReturn = m.currPage
+	}
+	m.currPage = noticePage
+	return m.withNoticeView()
+}
+
+func (m model) withNoticeView() model {
+	var sections []string
+	for _, n := range m.electionNotices() {
+		section := []string{m.noticeStyle(n).Render(m.tf("Notice from %s", n.from))}
+		if n.text != "" {
+			section = append(section, m.theme.fieldValue(utils.Wrap(n.text, m.width-4)))
+		}
+		if n.url != "" {
+			section = append(section, m.theme.fieldLabel(m.t("More information"))+": "+m.theme.fieldValue(n.url))
+		}
+		sections = append(sections, strings.Join(section, "\n"))
+	}
+	content := strings.Join(sections, "\n\n")
+
+	// Room for the header and margins
+	height := min(lipgloss.Height(content), max(1, m.height-8))
+	m.noticeView = viewport.New(viewport.WithWidth(m.width-2), viewport.WithHeight(height))
+	m.noticeView.SetContent(content)
+	return m
+}
+
+func (m model) updateNotice(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok {
+		switch {
+		case key.Matches(keyMsg, m.keys.Back):
+			m.currPage = m.parentPage()
+			return m, nil
+		}
+	}
+	var cmd tea.Cmd
+	m.noticeView, cmd = m.noticeView.Update(msg)
+	return m, cmd
+}
+
+func (m model) viewNotice() string {
+	return lipgloss.NewStyle().Margin(1, 1).MaxWidth(m.width).MaxHeight(m.height).Render(lipgloss.JoinVertical(
+		lipgloss.Top,
+		m.HeaderView(),
+		m.noticeView.View(),
+		m.helpFooter(),
+	))
+}
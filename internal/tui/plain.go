@@ -0,0 +1,141 @@
+package tui
+
+import (
+	"strconv"
+	"strings"
+
+	"charm.land/bubbles/v2/list"
+	"charm.land/bubbles/v2/paginator"
+	tea "charm.land/bubbletea/v2"
+	huh "charm.land/huh/v2"
+	"charm.land/lipgloss/v2"
+)
+
+// Plain mode is for screen readers and other assistive technology. Pages
+// are drawn as lines of text in the terminal's normal buffer rather than
+// the alternate screen: no borders or box drawing, numbered menus instead
+// of tabs, and anything a color would say spelled out in words.
+
+// plainKey switches plain mode on or off on any page. Like languageKey it's
+// a control key so it can't be mistaken for typing in the address form.
+const plainKey = "ctrl+r"
+
+// withPlain starts a session in plain mode, or not.
+func (m model) withPlain(plain bool) model {
+	m.plain = plain
+	m.form = m.newAddressForm(m.input)
+	return m
+}
+
+// togglePlain switches plain mode on or off, keeping the user's place.
+func (m model) togglePlain() (model, tea.Cmd) {
+	m.plain = !m.plain
+	return m.redraw()
+}
+
+// plainHint offers to switch plain mode on or off.
+func (m model) plainHint() string {
+	keyStyle := lipgloss.NewStyle().Foreground(m.theme.Accent).Render
+	hintStyle := lipgloss.NewStyle().Foreground(m.theme.Muted).Render
+	if m.plain {
+		return keyStyle("[Ctrl+R]") + hintStyle(" "+m.t("Full screen"))
+	}
+	return keyStyle("[Ctrl+R]") + hintStyle(" "+m.t("Plain text for screen readers"))
+}
+
+// menuPages are the header's tabs, numbered from 1 in plain mode.
+func (m model) menuPages() []page {
+	pages := []page{votePage, contestsPage, registerPage, timelinePage}
+	if m.savedList != nil {
+		pages = append(pages, savedAddressPage)
+	}
+	return pages
+}
+
+// pageNames name the header's tabs.
+var pageNames = map[page]string{
+	votePage:         "Vote",
+	contestsPage:     "Contests",
+	registerPage:     "Register",
+	timelinePage:     "Timeline",
+	savedAddressPage: "Addresses",
+}
+
+// openMenuPage opens the header tab numbered n, counting from 1.
+func (m model) openMenuPage(n int) model {
+	pages := m.menuPages()
+	if n < 1 || n > len(pages) {
+		return m
+	}
+	if pages[n-1] == savedAddressPage {
+		return m.showSavedAddresses()
+	}
+	m.currPage = pages[n-1]
+	return m
+}
+
+// plainHeader is the header in plain mode: where the user is, then a
+// numbered menu of the pages, or on a page opened from another, how to go
+// back.
+func (m model) plainHeader() string {
+	keyStyle := lipgloss.NewStyle().Foreground(m.theme.Accent).Render
+
+	var lines []string
+	if name, ok := pageNames[m.currPage]; ok {
+		lines = append(lines, "govote.sh: "+m.t(name))
+		menu := make([]string, 0, len(m.menuPages()))
+		for i, p := range m.menuPages() {
+			menu = append(menu, keyStyle("["+strconv.Itoa(i+1)+"]")+" "+m.t(pageNames[p]))
+		}
+		lines = append(lines, m.t("Menu:")+" "+strings.Join(menu, ", "))
+	} else {
+		lines = append(lines, "govote.sh", keyStyle(keyLabel(m.keys.Back))+" "+m.t("Back"))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// plainList has l number its items, marking the selected one with an arrow
+// instead of a color and border, and count its pages in numbers rather
+// than dots. d gives the lines under each item's title.
+func (m model) plainList(l *list.Model, d detailDelegate) {
+	if !m.plain {
+		return
+	}
+	d.plain = true
+	l.SetDelegate(d)
+	l.Paginator.Type = paginator.Arabic
+}
+
+// descriptionDelegate lists items by title and description, like bubbles'
+// default delegate.
+func descriptionDelegate(theme Theme) detailDelegate {
+	return newDetailDelegate(2, theme, func(item list.Item) []string {
+		if i, ok := item.(list.DefaultItem); ok {
+			return []string{i.Description()}
+		}
+		return nil
+	})
+}
+
+// plainTitled is a list item whose title reads differently in plain mode,
+// such as a section header whose arrow says whether it's folded.
+type plainTitled interface {
+	plainTitle() string
+}
+
+// plainFormTheme draws the address form without borders or colored
+// buttons: the field being filled in is marked with an arrow, and the
+// chosen answer to a yes or no question with an x.
+func plainFormTheme(isDark bool) *huh.Styles {
+	t := huh.ThemeBase(isDark)
+	t.Focused.Base = lipgloss.NewStyle()
+	t.Focused.Card = t.Focused.Base
+	t.Focused.Title = lipgloss.NewStyle().SetString(">")
+	t.Focused.Description = lipgloss.NewStyle().PaddingLeft(2)
+	t.Focused.FocusedButton = lipgloss.NewStyle().MarginRight(2).SetString("[x]")
+	t.Focused.BlurredButton = lipgloss.NewStyle().MarginRight(2).SetString("[ ]")
+
+	t.Blurred = t.Focused
+	t.Blurred.Title = lipgloss.NewStyle().PaddingLeft(2)
+	return t
+}
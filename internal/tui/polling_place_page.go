@@ -4,73 +4,80 @@ import (
 	"fmt"
 	"strings"
 
+	"charm.land/bubbles/v2/key"
 	"charm.land/bubbles/v2/table"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
-	"github.com/govote-sh/govote/internal/api"
+	"github.com/govote-sh/govote/internal/i18n"
 )
 
 func (m model) viewPollingPlace() string {
 	// Check if list manager is nil
 	if m.lm == nil {
-		return m.renderPageError("No polling place selected")
+		return m.renderPageError(m.t("No polling place selected"))
 	}
 
 	// Check if selected item exists
 	selectedItem := m.lm.SelectedItem()
 	if selectedItem == nil {
-		return m.renderPageError("No polling place selected")
+		return m.renderPageError(m.t("No polling place selected"))
 	}
 
 	// Type assert with safety check
-	selectedPollingPlace, ok := selectedItem.(api.PollingPlace)
+	selectedPollingPlace, ok := pollingPlaceItem(selectedItem)
 	if !ok {
-		return m.renderPageError("Invalid polling place data")
+		return m.renderPageError(m.t("Invalid polling place data"))
 	}
 
 	// Title and bold styles
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("205")).
-		Background(lipgloss.Color("63")).
+		Foreground(m.theme.TitleText).
+		Background(m.theme.TitleBackground).
 		Padding(0, 1).
 		Render
 	boldStyle := lipgloss.NewStyle().Bold(true).Render
 
-	title := titleStyle("Polling Place Details")
+	title := titleStyle(m.t("Polling Place Details"))
 
 	address := boldStyle(selectedPollingPlace.Address.String())
 
-	hoursTable := newPollingPlaceHoursTable(selectedPollingPlace.PollingHours)
+	hours := newPollingPlaceHoursTable(selectedPollingPlace.Hours, m.locale, m.theme).View()
+	if m.plain {
+		hours = plainPollingHours(selectedPollingPlace.Hours, m.locale)
+	}
 
 	// Notes (if any)
 	var notes string
 	if selectedPollingPlace.Notes != "" {
-		notes = boldStyle("Notes: ") + fieldValueStyle(selectedPollingPlace.Notes)
+		notes = boldStyle(m.t("Notes:")+" ") + m.theme.fieldValue(selectedPollingPlace.Notes)
 	}
 
 	// Voter services (if any)
 	var voterServices string
 	if selectedPollingPlace.VoterServices != "" {
-		voterServices = boldStyle("Voter Services: ") + fieldValueStyle(selectedPollingPlace.VoterServices)
+		voterServices = boldStyle(m.t("Voter Services:")+" ") + m.theme.fieldValue(selectedPollingPlace.VoterServices)
 	}
 
 	// Start and end dates (if any)
 	var dates string
-	if selectedPollingPlace.StartDate != "" && selectedPollingPlace.EndDate != "" {
-		if selectedPollingPlace.StartDate == selectedPollingPlace.EndDate {
-			dates = fmt.Sprintf("%s: %s", boldStyle("Date"), fieldValueStyle(selectedPollingPlace.StartDate))
-		} else {
-			dates = fmt.Sprintf("%s: %s → %s", boldStyle("Available Dates"), selectedPollingPlace.StartDate, selectedPollingPlace.EndDate)
-		}
-	} else {
-		dates = ""
+	start, end := selectedPollingPlace.StartDate, selectedPollingPlace.EndDate
+	switch {
+	case start.IsZero() && end.IsZero():
+	case start.Equal(end):
+		dates = fmt.Sprintf("%s: %s (%s)", boldStyle(m.t("Date")), m.theme.fieldValue(formatDate(start, m.locale)), relativeDay(start, m.now(), m.locale))
+	case start.IsZero():
+		dates = fmt.Sprintf("%s: %s (%s)", boldStyle(m.t("Until")), m.theme.fieldValue(formatDate(end, m.locale)), relativeWindow(start, end, m.now(), m.locale))
+	case end.IsZero():
+		dates = fmt.Sprintf("%s: %s (%s)", boldStyle(m.t("From")), m.theme.fieldValue(formatDate(start, m.locale)), relativeWindow(start, end, m.now(), m.locale))
+	default:
+		dates = fmt.Sprintf("%s: %s → %s (%s)", boldStyle(m.t("Available Dates")), m.theme.fieldValue(formatDate(start, m.locale)), m.theme.fieldValue(formatDate(end, m.locale)), relativeWindow(start, end, m.now(), m.locale))
 	}
 
 	// Latitude and Longitude (if any)
 	var coordinates string
 	if url, err := selectedPollingPlace.GetMapsUrl(); err == nil {
-		coordinates = boldStyle("Map link: ") + fieldValueStyle(url)
+		coordinates = boldStyle(m.t("Map link:")+" ") + m.theme.fieldValue(url)
 	}
 
 	return lipgloss.NewStyle().Margin(1, 1).MaxWidth(m.width).MaxHeight(m.height).Render(
@@ -80,7 +87,7 @@ func (m model) viewPollingPlace() string {
 			title,
 			address,
 			"\t",
-			hoursTable.View(),
+			hours,
 			"\t",
 			notes,
 			voterServices,
@@ -91,26 +98,19 @@ func (m model) viewPollingPlace() string {
 }
 
 func (m model) updatePollingPlace(msg tea.Msg) (tea.Model, tea.Cmd) {
-	// Allow the user to exit by pressing "q" or "ctrl+c"
-	if keyMsg, ok := msg.(tea.KeyPressMsg); ok {
-		switch keyMsg.String() {
-		case "esc":
-			if m.lm != nil && !m.lm.SettingFilter() {
-				m.currPage = votePage
-			}
-			return m, nil
-		}
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok && key.Matches(keyMsg, m.keys.Back) {
+		m.currPage = m.parentPage()
 	}
 	return m, nil
 }
 
-func newPollingPlaceHoursTable(hours string) table.Model {
+func newPollingPlaceHoursTable(hours string, loc i18n.Locale, theme Theme) table.Model {
 	pollingHours := parsePollingHours(hours)
 
 	// Define columns for the table
 	columns := []table.Column{
-		{Title: "Day", Width: 20},
-		{Title: "Hours", Width: 24},
+		{Title: loc.T("Day"), Width: 20},
+		{Title: loc.T("Hours"), Width: 24},
 	}
 
 	// Create rows based on polling hours
@@ -129,13 +129,26 @@ func newPollingPlaceHoursTable(hours string) table.Model {
 	t := table.New(table.WithColumns(columns), table.WithRows(rows), table.WithHeight(tableHeight), table.WithWidth(sumColumnWidths(columns)))
 
 	t.SetStyles(table.Styles{
-		Header: lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("205")),
-		Cell:   lipgloss.NewStyle().Foreground(lipgloss.Color("255")),
+		Header: lipgloss.NewStyle().Bold(true).Foreground(theme.Accent),
+		Cell:   lipgloss.NewStyle().Foreground(theme.Text),
 	})
 
 	return t
 }
 
+// plainPollingHours lists the hours a line per day, for plain mode.
+func plainPollingHours(hours string, loc i18n.Locale) string {
+	pollingHours := parsePollingHours(hours)
+	if len(pollingHours) == 0 {
+		return ""
+	}
+	lines := []string{loc.T("Hours") + ":"}
+	for _, entry := range pollingHours {
+		lines = append(lines, entry[0]+": "+entry[1])
+	}
+	return strings.Join(lines, "\n")
+}
+
 func parsePollingHours(pollingHours string) [][2]string {
 	var result [][2]string
 
@@ -48,8 +48,8 @@ func TestEmptySubmitShowsErrorThenRecovers(t *testing.T) {
 		containsBytes("at least one address field is required"),
 		teatest.WithDuration(3*time.Second))
 
-	// Any key returns to a fresh form.
-	tm.Type("x")
+	// Edit returns to the form.
+	tm.Type("e")
 	teatest.WaitFor(t, tm.Output(), containsBytes("Welcome to govote.sh!"),
 		teatest.WithDuration(3*time.Second))
 }
@@ -0,0 +1,163 @@
+package tui
+
+import (
+	"fmt"
+	"image/color"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/govote-sh/govote/internal/domain"
+	"github.com/govote-sh/govote/internal/utils"
+)
+
+// twoColumnMinWidth is the narrowest window the pro and con statements are
+// shown side by side in; below it they're stacked.
+const twoColumnMinWidth = 80
+
+// openContest shows the selected contest on its page: ballot measures on
+// the referendum page, races on the contest page.
+func (m model) openContest() model {
+	item, ok := m.contestsList.SelectedItem().(contestItem)
+	if !ok {
+		return m
+	}
+	if item.Referendum == nil {
+		m.currPage = contestContentPage
+		m.candidateCursor = 0
+		m.compareMarks = nil
+		return m
+	}
+	m.currPage = referendumPage
+	return m.withReferendumView()
+}
+
+// withReferendumView lays out the selected ballot measure for the window.
+func (m model) withReferendumView() model {
+	item, ok := m.contestsList.SelectedItem().(contestItem)
+	if !ok || item.Referendum == nil {
+		return m
+	}
+	content := m.formatReferendum(item.Contest)
+
+	// Room for the header, any notice banner, margins and the key hints
+	height := min(lipgloss.Height(content), max(1, m.height-8-m.bannerHeight()))
+	m.referendumView = viewport.New(viewport.WithWidth(m.width-2), viewport.WithHeight(height))
+	m.referendumView.SetContent(content)
+	return m
+}
+
+func (m model) formatReferendum(c domain.Contest) string {
+	r := c.Referendum
+	width := m.width - 2
+	wrap := func(s string) string { return m.theme.fieldValue(utils.Wrap(s, width)) }
+	hintStyle := lipgloss.NewStyle().Foreground(m.theme.Muted).Render
+
+	var sections []string
+
+	heading := []string{m.theme.sectionTitle(contestName(c))}
+	if r.Subtitle != "" {
+		heading = append(heading, hintStyle(r.Subtitle))
+	}
+	if r.Brief != "" {
+		heading = append(heading, "", wrap(r.Brief))
+	}
+	sections = append(sections, strings.Join(heading, "\n"))
+
+	// What's on the ballot and how it's decided
+	var decision []string
+	if len(r.BallotResponses) > 0 {
+		choices := make([]string, 0, len(r.BallotResponses))
+		for _, response := range r.BallotResponses {
+			choices = append(choices, "○ "+response)
+		}
+		joined := strings.Join(choices, "   ")
+		if m.plain {
+			joined = strings.Join(r.BallotResponses, ", ")
+		}
+		decision = append(decision, fmt.Sprintf("%s: %s", m.theme.fieldLabel(m.t("Choices")), m.theme.fieldValue(joined)))
+	}
+	if r.PassageThreshold != "" {
+		decision = append(decision, fmt.Sprintf("%s: %s", m.theme.fieldLabel(m.t("Passes with")), m.theme.fieldValue(r.PassageThreshold)))
+	}
+	if r.EffectOfAbstain != "" {
+		decision = append(decision, fmt.Sprintf("%s:\n%s", m.theme.fieldLabel(m.t("If you don't vote on it")), wrap(r.EffectOfAbstain)))
+	}
+	if len(decision) > 0 {
+		sections = append(sections, strings.Join(decision, "\n"))
+	}
+
+	if statements := m.proConStatements(r); statements != "" {
+		sections = append(sections, statements)
+	}
+
+	if r.Text != "" {
+		sections = append(sections, m.theme.sectionTitle(m.t("Full Text"))+"\n"+wrap(r.Text))
+	}
+	if r.URL != "" {
+		sections = append(sections, fmt.Sprintf("%s: %s", m.theme.fieldLabel(m.t("More information")), m.theme.fieldValue(r.URL)))
+	}
+
+	return strings.Join(sections, "\n\n")
+}
+
+// proConStatements shows the arguments for and against side by side on
+// wide windows, and one above the other on narrow ones and in plain mode.
+func (m model) proConStatements(r *domain.Referendum) string {
+	width := m.width - 2
+	column := func(title string, accent color.Color, text string, width int) string {
+		if text == "" {
+			return ""
+		}
+		style := lipgloss.NewStyle().Width(width)
+		if !m.plain {
+			style = style.Border(lipgloss.ThickBorder(), false, false, false, true).BorderForeground(accent).PaddingLeft(1)
+		}
+		return style.Render(lipgloss.NewStyle().Bold(true).Foreground(accent).Render(title) + "\n" + m.theme.fieldValue(text))
+	}
+
+	green, red := m.theme.Positive, m.theme.Alert
+	if m.width >= twoColumnMinWidth && !m.plain && r.ProStatement != "" && r.ConStatement != "" {
+		half := (width - 2) / 2
+		return lipgloss.JoinHorizontal(lipgloss.Top,
+			column(m.t("For"), green, r.ProStatement, half),
+			"  ",
+			column(m.t("Against"), red, r.ConStatement, half),
+		)
+	}
+	var stacked []string
+	for _, c := range []string{column(m.t("For"), green, r.ProStatement, width), column(m.t("Against"), red, r.ConStatement, width)} {
+		if c != "" {
+			stacked = append(stacked, c)
+		}
+	}
+	return strings.Join(stacked, "\n\n")
+}
+
+func (m model) updateReferendum(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok {
+		switch {
+		case key.Matches(keyMsg, m.keys.Back):
+			m.currPage = m.parentPage()
+			return m, nil
+		}
+	}
+	var cmd tea.Cmd
+	m.referendumView, cmd = m.referendumView.Update(msg)
+	return m, cmd
+}
+
+func (m model) viewReferendum() string {
+	if _, ok := m.contestsList.SelectedItem().(contestItem); !ok {
+		return m.renderPageError(m.t("No ballot measure selected"))
+	}
+
+	return lipgloss.NewStyle().Margin(1, 1).MaxWidth(m.width).MaxHeight(m.height).Render(joinNonEmptyVertical(
+		lipgloss.Top,
+		m.HeaderView(),
+		m.referendumView.View(),
+		m.helpFooter(),
+	))
+}
@@ -4,113 +4,283 @@ import (
 	"fmt"
 	"strings"
 
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/list"
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
-	"github.com/govote-sh/govote/internal/api"
+	"github.com/govote-sh/govote/internal/domain"
+	"github.com/govote-sh/govote/internal/i18n"
+	"github.com/govote-sh/govote/internal/utils"
 )
 
-func formatElectionAdministration(admin api.ElectionAdministrationBody) string {
+// adminPanel is one election office on the register page: a state's, or
+// the local jurisdiction's below it.
+type adminPanel struct {
+	tab   string // Short name for the panel switcher, e.g. "Local: Richmond City"
+	title string // Heading, e.g. "Register in Virginia"
+	admin domain.Administration
+}
+
+// adminPanels lists every state's office, each followed by its local one.
+func (m model) adminPanels() []adminPanel {
+	var panels []adminPanel
+	for _, state := range m.electionData.States {
+		panels = append(panels, adminPanel{
+			tab:   m.tf("State: %s", state.Name),
+			title: m.tf("Register in %s", state.Name),
+			admin: state.Administration,
+		})
+		if local := state.LocalJurisdiction; local != nil {
+			panels = append(panels, adminPanel{
+				tab:   m.tf("Local: %s", local.Name),
+				title: m.tf("Local Jurisdiction: %s", local.Name),
+				admin: local.Administration,
+			})
+		}
+	}
+	return panels
+}
+
+func formatElectionAdministration(admin domain.Administration, width int, loc i18n.Locale, theme Theme) string {
 	var sections []string
 
 	// Title for Election Administration section
-	sections = append(sections, sectionTitleStyle("Election Administration"))
-	sections = append(sections, fieldValueStyle(admin.Name))
+	sections = append(sections, theme.sectionTitle(loc.T("Election Administration")))
+	sections = append(sections, theme.fieldValue(admin.Name))
 
 	// Append URLs if they exist
 	urlFields := []struct {
 		label string
 		value string
 	}{
-		{"Election Info", admin.ElectionInfoUrl},
-		{"Registration URL", admin.ElectionRegistrationUrl},
-		{"Confirmation URL", admin.ElectionRegistrationConfirmationUrl},
-		{"Absentee Voting Info", admin.AbsenteeVotingInfoUrl},
-		{"Location Finder", admin.VotingLocationFinderUrl},
-		{"Ballot Info", admin.BallotInfoUrl},
-		{"Election Rules", admin.ElectionRulesUrl},
+		{"Election Info", admin.ElectionInfoURL},
+		{"Registration URL", admin.RegistrationURL},
+		{"Confirmation URL", admin.RegistrationConfirmationURL},
+		{"Absentee Voting Info", admin.AbsenteeVotingInfoURL},
+		{"Location Finder", admin.VotingLocationFinderURL},
+		{"Ballot Info", admin.BallotInfoURL},
+		{"Election Rules", admin.ElectionRulesURL},
 	}
 	for _, field := range urlFields {
 		if field.value != "" {
-			sections = append(sections, fmt.Sprintf("%s: %s", fieldLabelStyle(field.label), fieldValueStyle(field.value)))
+			sections = append(sections, fmt.Sprintf("%s: %s", theme.fieldLabel(loc.T(field.label)), theme.fieldValue(field.value)))
 		}
 	}
 
 	// Append Hours of Operation if they exist
 	if admin.HoursOfOperation != "" {
-		sections = append(sections, fmt.Sprintf("%s: %s", fieldLabelStyle("Hours of Operation"), fieldValueStyle(admin.HoursOfOperation)))
+		sections = append(sections, fmt.Sprintf("%s: %s", theme.fieldLabel(loc.T("Hours of Operation")), theme.fieldValue(admin.HoursOfOperation)))
 	}
 
 	// Voter Services if they exist
 	if len(admin.VoterServices) > 0 {
-		sections = append(sections, sectionTitleStyle("Voter Services"))
-		sections = append(sections, fieldValueStyle(strings.Join(admin.VoterServices, ", ")))
+		sections = append(sections, theme.sectionTitle(loc.T("Voter Services")))
+		sections = append(sections, theme.fieldValue(utils.Wrap(strings.Join(admin.VoterServices, ", "), width)))
 	}
 
 	// Correspondence Address
-	if admin.CorrespondenceAddress != (api.Address{}) {
-		sections = append(sections, sectionTitleStyle("Correspondence Address"))
-		sections = append(sections, fieldValueStyle(admin.CorrespondenceAddress.String()))
+	if admin.CorrespondenceAddress != (domain.Address{}) {
+		sections = append(sections, theme.sectionTitle(loc.T("Correspondence Address")))
+		sections = append(sections, theme.fieldValue(admin.CorrespondenceAddress.String()))
 	}
 
 	// Physical Address
-	if admin.PhysicalAddress != (api.Address{}) {
-		sections = append(sections, sectionTitleStyle("Physical Address"))
-		sections = append(sections, fieldValueStyle(admin.PhysicalAddress.String()))
-	}
-
-	// Election Officials
-	if len(admin.ElectionOfficials) > 0 {
-		sections = append(sections, sectionTitleStyle("Election Officials"))
-		for _, official := range admin.ElectionOfficials {
-			officialInfo := []string{fieldValueStyle(official.Name)}
-			if official.Title != "" {
-				officialInfo = append(officialInfo, fmt.Sprintf("Title: %s", fieldValueStyle(official.Title)))
-			}
-			if official.OfficePhoneNumber != "" {
-				officialInfo = append(officialInfo, fmt.Sprintf("Office Phone: %s", fieldValueStyle(official.OfficePhoneNumber)))
-			}
-			if official.EmailAddress != "" {
-				officialInfo = append(officialInfo, fmt.Sprintf("Email: %s", fieldValueStyle(official.EmailAddress)))
-			}
-			sections = append(sections, strings.Join(officialInfo, ", "))
-		}
+	if admin.PhysicalAddress != (domain.Address{}) {
+		sections = append(sections, theme.sectionTitle(loc.T("Physical Address")))
+		sections = append(sections, theme.fieldValue(admin.PhysicalAddress.String()))
 	}
 
 	return strings.Join(sections, "\n")
 }
 
-func formatStateResource(state api.State) string {
-	var stateDisplay []string
+// withRegisterPanel shows panel i on the register page, rebuilding its
+// scrollable details and officials list.
+func (m model) withRegisterPanel(i int) model {
+	panels := m.adminPanels()
+	if len(panels) == 0 {
+		return m
+	}
+	i = (i + len(panels)) % len(panels)
+	m.registerPanel = i
+	panel := panels[i]
+
+	// Size the viewport to its content so short panels aren't padded out
+	details := formatElectionAdministration(panel.admin, m.width-4, m.locale, m.theme)
+	height := min(lipgloss.Height(details), m.registerViewHeight())
+	m.registerView = viewport.New(viewport.WithWidth(m.width-4), viewport.WithHeight(height))
+	m.registerView.SetContent(details)
+
+	items := []list.Item{}
+	for _, official := range panel.admin.Officials {
+		items = append(items, officialItem{official})
+	}
+	officials := list.New(items, m.theme.defaultDelegate(), m.width, m.listHeight())
+	officials.Title = m.tf("Election Officials: %s", panel.admin.Name)
+	m.searchable(&officials)
+	m.plainList(&officials, descriptionDelegate(m.theme))
+	m.officialsList = &officials
+	return m
+}
+
+// registerViewHeight leaves room for the header, any notice banner, the
+// panel switcher, title and help footer.
+func (m model) registerViewHeight() int {
+	return max(1, m.height-10-m.bannerHeight())
+}
+
+func (m model) updateRegister(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok {
+		switch {
+		case key.Matches(keyMsg, m.keys.NextOffice):
+			return m.withRegisterPanel(m.registerPanel + 1), nil
+		case key.Matches(keyMsg, m.keys.PrevOffice):
+			return m.withRegisterPanel(m.registerPanel - 1), nil
+		case key.Matches(keyMsg, m.keys.Officials):
+			if m.officialsList != nil && len(m.officialsList.Items()) > 0 {
+				m.currPage = officialsPage
+			}
+			return m, nil
+		}
+	}
+	var cmd tea.Cmd
+	m.registerView, cmd = m.registerView.Update(msg)
+	return m, cmd
+}
+
+func (m model) viewRegister() string {
+	panels := m.adminPanels()
+	if len(panels) == 0 {
+		return m.renderPageError(m.t("No registration information available."))
+	}
+	panel := panels[m.registerPanel]
 
-	// Main header for State
 	mainHeaderStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("205")).
-		Background(lipgloss.Color("63")).
+		Foreground(m.theme.TitleText).
+		Background(m.theme.TitleBackground).
 		Padding(0, 1).
 		Render
-	stateDisplay = append(stateDisplay, mainHeaderStyle(fmt.Sprintf("Register in %s", state.Name)))
+	activeStyle := lipgloss.NewStyle().Bold(true).Foreground(m.theme.Accent).Render
+	inactiveStyle := lipgloss.NewStyle().Foreground(m.theme.Muted).Render
 
-	stateDisplay = append(stateDisplay, formatElectionAdministration(state.ElectionAdministrationBody))
+	var tabs []string
+	for i, p := range panels {
+		switch {
+		case i != m.registerPanel:
+			tabs = append(tabs, inactiveStyle(p.tab))
+		case m.plain:
+			tabs = append(tabs, activeStyle(p.tab+" "+m.t("(shown)")))
+		default:
+			tabs = append(tabs, activeStyle(p.tab))
+		}
+	}
+	separator := " │ "
+	if m.plain {
+		separator = ", "
+	}
 
-	if state.LocalJurisdiction != nil {
-		stateDisplay = append(stateDisplay, lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("205")).Render("Local Jurisdiction: "+state.LocalJurisdiction.Name))
-		stateDisplay = append(stateDisplay, formatElectionAdministration(state.LocalJurisdiction.ElectionAdministrationBody))
+	return lipgloss.NewStyle().Margin(1, 1).MaxWidth(m.width).MaxHeight(m.height).Render(
+		joinNonEmptyVertical(
+			lipgloss.Top,
+			m.HeaderView(),
+			strings.Join(tabs, inactiveStyle(separator)),
+			mainHeaderStyle(panel.title),
+			m.registerView.View(),
+			m.helpFooter(),
+		),
+	)
+}
+
+// officialItem is an election official in the officials list.
+type officialItem struct {
+	official domain.Official
+}
+
+func (o officialItem) FilterValue() string { return o.official.Name + " " + o.official.Title }
+func (o officialItem) Title() string       { return o.official.Name }
+
+func (o officialItem) Description() string {
+	var parts []string
+	for _, s := range []string{o.official.Title, o.official.Phone} {
+		if s != "" {
+			parts = append(parts, s)
+		}
+	}
+	return strings.Join(parts, " · ")
+}
+
+func (m model) updateOfficials(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if m.officialsList == nil {
+		return m, nil
+	}
+	officialsList, cmd := m.officialsList.Update(msg)
+	m.officialsList = &officialsList
+	if cmd != nil {
+		return m, cmd
+	}
+
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok {
+		switch {
+		case key.Matches(keyMsg, m.keys.Details):
+			return m.openSelected()
+		case key.Matches(keyMsg, m.keys.Back):
+			if !m.officialsList.IsFiltered() {
+				m.currPage = m.parentPage()
+			}
+		}
 	}
+	return m, nil
+}
 
-	return strings.Join(stateDisplay, "\n\n")
+func (m model) viewOfficials() string {
+	if m.officialsList == nil {
+		return m.renderPageError(m.t("No election officials listed"))
+	}
+	return lipgloss.NewStyle().Margin(1, 1).MaxWidth(m.width).MaxHeight(m.height).Render(lipgloss.JoinVertical(
+		lipgloss.Top,
+		m.HeaderView(),
+		m.officialsList.View(),
+		m.helpFooter(),
+	))
 }
 
-func (m model) viewRegister() string {
-	if len(m.electionData.State) == 0 {
-		return "No registration information available."
+func (m model) updateOfficial(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok && key.Matches(keyMsg, m.keys.Back) {
+		m.currPage = m.parentPage()
+	}
+	return m, nil
+}
+
+func (m model) viewOfficial() string {
+	if m.officialsList == nil {
+		return m.renderPageError(m.t("No official selected"))
+	}
+	item, ok := m.officialsList.SelectedItem().(officialItem)
+	if !ok {
+		return m.renderPageError(m.t("No official selected"))
+	}
+
+	official := item.official
+	var fields []string
+	for _, field := range []struct{ label, value string }{
+		{"Title", official.Title},
+		{"Office Phone", official.Phone},
+		{"Fax", official.Fax},
+		{"Email", official.Email},
+	} {
+		if field.value != "" {
+			fields = append(fields, fmt.Sprintf("%s: %s", m.theme.fieldLabel(m.t(field.label)), m.theme.fieldValue(field.value)))
+		}
 	}
 
-	stateInfo := formatStateResource(m.electionData.State[0])
-	return lipgloss.NewStyle().Margin(1, 2).MaxWidth(m.width).MaxHeight(m.height).Render(
-		lipgloss.JoinVertical(
+	return lipgloss.NewStyle().Margin(1, 1).MaxWidth(m.width).MaxHeight(m.height).Render(
+		joinNonEmptyVertical(
 			lipgloss.Top,
 			m.HeaderView(),
-			stateInfo,
+			m.theme.sectionTitle(official.Name),
+			strings.Join(fields, "\n"),
+			m.helpFooter(),
 		),
 	)
 }
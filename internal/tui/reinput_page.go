@@ -0,0 +1,81 @@
+package tui
+
+import (
+	"errors"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"charm.land/log/v2"
+	"github.com/govote-sh/govote/internal/address"
+	"github.com/govote-sh/govote/internal/api"
+	"github.com/govote-sh/govote/internal/utils"
+)
+
+// suspectAddressField guesses which field of the submitted address caused
+// err, so the form can reopen with that field focused.
+func suspectAddressField(err *utils.ErrMsg, input address.InputAddress) string {
+	switch {
+	case err == nil || input.IsEmpty():
+		return "street"
+	case errors.Is(err.Err, api.ErrNoElectionDay):
+		// The address parsed but no election covers it: most often the
+		// wrong state
+		return "state"
+	case err.Reason == "parseError" || (err.HTTPStatusCode >= 400 && err.HTTPStatusCode < 500):
+		// An address that won't parse is usually missing a piece
+		for i, value := range []string{input.Street, input.City, input.State} {
+			if value == "" {
+				return addressFieldKeys[i]
+			}
+		}
+		return "street"
+	}
+	// Server and transport errors say nothing about the address itself
+	return "street"
+}
+
+func (m model) updateReinputConfirmation(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok {
+		switch {
+		case key.Matches(keyMsg, m.keys.Retry):
+			// Rebuild the form around what the user already typed
+			field := suspectAddressField(m.err, m.input)
+			m.form = m.newAddressForm(m.input)
+			m.err = nil
+			m.currPage = inputPage
+			initCmd := m.form.Init()
+			return m, tea.Batch(initCmd, focusAddressField(m.form, field))
+		case key.Matches(keyMsg, m.keys.StartOver):
+			m.input = address.InputAddress{}
+			m.form = m.newAddressForm(m.input)
+			m.err = nil
+			m.currPage = inputPage
+			return m, m.form.Init()
+		case key.Matches(keyMsg, m.keys.Quit):
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+func (m model) viewReinputConfirmation() string {
+	var errorMsg string
+	if m.err == nil {
+		errorMsg = m.t("Error: unknown error")
+	} else if m.err.HTTPStatusCode >= 400 && m.err.HTTPStatusCode < 500 { // Client error
+		errorMsg = m.tf("Error: Client error (code: %d): This is likely due to an invalid address\nor the voter information project not being up to date\nPlease check https://all.votinginfotool.org", m.err.HTTPStatusCode)
+	} else if m.err.HTTPStatusCode >= 500 && m.err.HTTPStatusCode < 600 { // Server error
+		errorMsg = m.tf("Error: Server error (code: %d): This is likely due to the API being down\nPlease check https://all.votinginfotool.org to make sure", m.err.HTTPStatusCode)
+	} else {
+		log.Error(m.err.Err)
+		// Errors meant for users are in the catalogs; others show as is
+		errorMsg = m.tf("Error: %v", m.t(m.err.Err.Error()))
+	}
+
+	return lipgloss.NewStyle().Margin(1, 1).MaxWidth(m.width).MaxHeight(m.height).Render(lipgloss.JoinVertical(
+		lipgloss.Top,
+		errorMsg,
+		m.helpFooter(),
+	))
+}
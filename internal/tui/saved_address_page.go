@@ -0,0 +1,210 @@
+package tui
+
+import (
+	"errors"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/list"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/govote-sh/govote/internal/address"
+	"github.com/govote-sh/govote/internal/store"
+	"github.com/govote-sh/govote/internal/utils"
+)
+
+// savedAddressesMsg carries the user's saved addresses after the store
+// changed them.
+type savedAddressesMsg []store.SavedAddress
+
+// forgottenMsg reports that the store deleted all of the user's saved
+// addresses.
+type forgottenMsg struct{}
+
+// storeErrMsg reports that the store could not save, remove or forget the
+// user's addresses.
+type storeErrMsg struct{ err error }
+
+// deletion is a deletion asked for on the saved address page, held until
+// the user confirms it: one saved address, or all of them.
+type deletion struct {
+	label string
+	all   bool
+}
+
+// storeNotice is what to tell the user when the store failed with err.
+func storeNotice(err error) string {
+	if errors.Is(err, store.ErrTooManyAddresses) {
+		return "Address not saved: delete a saved one to make room."
+	}
+	return "Could not update your saved addresses."
+}
+
+// savedAddressItem adapts a store.SavedAddress for the saved address list.
+type savedAddressItem store.SavedAddress
+
+func (s savedAddressItem) FilterValue() string {
+	return s.Label + " " + s.Address.String()
+}
+
+func (s savedAddressItem) Title() string {
+	return s.Label
+}
+
+func (s savedAddressItem) Description() string {
+	return s.Address.String()
+}
+
+// labelOrDefault returns label, or something recognizable for addr when
+// the user left the label blank.
+func labelOrDefault(label string, addr address.InputAddress) string {
+	if label = strings.TrimSpace(label); label != "" {
+		return label
+	}
+	if addr.Street != "" {
+		return utils.EllipticalTruncate(addr.Street, 40)
+	}
+	return utils.EllipticalTruncate(addr.String(), 40)
+}
+
+// withSavedAddresses replaces the user's saved addresses, keeping the list
+// selection where it was when possible.
+func (m model) withSavedAddresses(saved []store.SavedAddress) model {
+	if len(saved) == 0 {
+		m.savedList = nil
+		return m
+	}
+
+	items := make([]list.Item, 0, len(saved))
+	for _, s := range saved {
+		items = append(items, savedAddressItem(s))
+	}
+	selected := 0
+	if m.savedList != nil {
+		selected = min(m.savedList.Index(), len(items)-1)
+	}
+	l := list.New(items, m.theme.defaultDelegate(), m.width, m.height-4)
+	l.Title = m.t("Saved Addresses")
+	translateList(&l, m.locale)
+	m.theme.styleList(&l)
+	m.plainList(&l, descriptionDelegate(m.theme))
+	withFooter(&l)
+	l.Select(selected)
+	m.savedList = &l
+	return m
+}
+
+// showSavedAddresses switches to the saved address list from anywhere.
+func (m model) showSavedAddresses() model {
+	m.currPage = savedAddressPage
+	m.hasMenu = false
+	return m
+}
+
+func (m model) updateSavedAddress(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if m.savedList == nil {
+		m.form = m.newAddressForm(address.InputAddress{})
+		m.currPage = inputPage
+		return m, m.form.Init()
+	}
+
+	if m.deleting != nil {
+		return m.updateDeleting(msg)
+	}
+
+	typing := m.typing()
+	savedList, cmd := m.savedList.Update(msg)
+	m.savedList = &savedList
+	if cmd != nil || typing {
+		return m, cmd
+	}
+
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok {
+		switch {
+		case key.Matches(keyMsg, m.keys.LookUp):
+			if selected, ok := m.savedList.SelectedItem().(savedAddressItem); ok {
+				return m.lookup(selected.Address)
+			}
+		case key.Matches(keyMsg, m.keys.NewAddress):
+			m.form = m.newAddressForm(address.InputAddress{})
+			m.currPage = inputPage
+			return m, m.form.Init()
+		case key.Matches(keyMsg, m.keys.Back):
+			// Back to the results for the address looked up last
+			if m.electionData != nil {
+				return m.showResults(), nil
+			}
+		case key.Matches(keyMsg, m.keys.Delete):
+			if selected, ok := m.savedList.SelectedItem().(savedAddressItem); ok {
+				m.deleting = &deletion{label: selected.Label}
+			}
+		case key.Matches(keyMsg, m.keys.Quit):
+			return m, tea.Quit
+		case key.Matches(keyMsg, m.keys.ForgetAll):
+			m.deleting = &deletion{all: true}
+		}
+	}
+	return m, nil
+}
+
+// updateDeleting asks the store to make the deletion once the user
+// confirms it, and drops it if they don't.
+func (m model) updateDeleting(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyPressMsg)
+	if !ok {
+		return m, nil
+	}
+	switch {
+	case key.Matches(keyMsg, m.keys.Accept):
+		d, st, userID := *m.deleting, m.store, m.userID
+		m.deleting = nil
+		if d.all {
+			return m, func() tea.Msg {
+				if err := st.Forget(userID); err != nil {
+					return storeErrMsg{err}
+				}
+				return forgottenMsg{}
+			}
+		}
+		return m, func() tea.Msg {
+			entry, err := st.Remove(userID, d.label)
+			if err != nil {
+				return storeErrMsg{err}
+			}
+			return savedAddressesMsg(entry.Addresses)
+		}
+	case key.Matches(keyMsg, m.keys.Reject):
+		m.deleting = nil
+	case key.Matches(keyMsg, m.keys.Quit):
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+// deletionQuestion asks the user to confirm the deletion they asked for.
+func (m model) deletionQuestion() string {
+	if m.deleting.all {
+		return m.tf("Delete all %d saved addresses? This can't be undone.", len(m.savedList.Items()))
+	}
+	return m.tf("Delete the saved address %q? This can't be undone.", m.deleting.label)
+}
+
+func (m model) viewSavedAddress() string {
+	if m.savedList == nil {
+		return m.viewInput()
+	}
+	savedList, question := *m.savedList, ""
+	if m.deleting != nil {
+		// The question takes the list's last lines, so the footer stays put
+		question = lipgloss.NewStyle().Bold(true).Foreground(m.theme.Alert).Render(utils.Wrap(m.deletionQuestion(), m.width-2))
+		savedList.SetHeight(max(0, savedList.Height()-lipgloss.Height(question)))
+	}
+	return lipgloss.NewStyle().Margin(1, 1).MaxWidth(m.width).MaxHeight(m.height).Render(
+		joinNonEmptyVertical(
+			lipgloss.Top,
+			savedList.View(),
+			question,
+			m.helpFooter(),
+		),
+	)
+}
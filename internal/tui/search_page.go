@@ -0,0 +1,225 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/list"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/govote-sh/govote/internal/domain"
+)
+
+// searchable turns off a results list's own filter, which "/" would
+// otherwise open, leaving "/" to the search page, and its own help, which
+// the help footer replaces. The rest of the list's own text is put in the
+// session's language and colors.
+func (m model) searchable(l *list.Model) {
+	translateList(l, m.locale)
+	m.theme.styleList(l)
+	withFooter(l)
+	l.SetFilteringEnabled(false)
+}
+
+// searchResult is one match on the search page.
+type searchResult struct {
+	group  string // Heading the result is listed under, e.g. "Candidates"
+	title  string
+	detail string
+
+	// Where the result lives: a contest, or a site in one of the vote
+	// page's lists
+	contest int // Index into electionData.Contests; -1 for sites
+	list    int // Index into voteLists
+	site    int // Index into that list's places
+}
+
+// matches reports whether every word of the query appears in one of fields.
+func matches(query string, fields ...string) bool {
+	text := strings.ToLower(strings.Join(fields, "\n"))
+	for _, word := range strings.Fields(strings.ToLower(query)) {
+		if !strings.Contains(text, word) {
+			return false
+		}
+	}
+	return true
+}
+
+// contestName is what a contest is called in search results: its ballot
+// title, falling back to the office or measure it's about.
+func contestName(c domain.Contest) string {
+	switch {
+	case c.BallotTitle != "":
+		return c.BallotTitle
+	case c.Referendum != nil && c.Referendum.Title != "":
+		return c.Referendum.Title
+	default:
+		return c.Office
+	}
+}
+
+// search finds query in the contests, candidates, ballot measures and
+// sites, grouped in that order.
+func (m model) search(query string) []searchResult {
+	if strings.TrimSpace(query) == "" || m.electionData == nil {
+		return nil
+	}
+
+	var contests, candidates, referendums []searchResult
+	for i, c := range m.electionData.Contests {
+		name := contestName(c)
+		if r := c.Referendum; r != nil {
+			// Ballot measures are only listed as such, not as contests too
+			if matches(query, c.BallotTitle, c.Office, r.Title, r.Subtitle, r.Brief, r.Text) {
+				referendums = append(referendums, searchResult{group: "Ballot Measures", title: name, detail: r.Subtitle, contest: i})
+			}
+		} else if matches(query, c.BallotTitle, c.Office) {
+			contests = append(contests, searchResult{group: "Contests", title: name, detail: c.District.Name, contest: i})
+		}
+
+		for _, cand := range c.Candidates {
+			if matches(query, cand.Name, cand.Party) {
+				detail := name
+				if cand.Party != "" {
+					detail = cand.Party + " · " + name
+				}
+				candidates = append(candidates, searchResult{group: "Candidates", title: cand.Name, detail: detail, contest: i})
+			}
+		}
+	}
+
+	results := append(append(contests, candidates...), referendums...)
+	for l, vl := range m.voteLists() {
+		for i, p := range vl.places {
+			if matches(query, p.Title(), p.Address.String()) {
+				detail := p.Address.String()
+				if m.isExpired(p) {
+					detail = m.t("Closed") + " · " + detail
+				}
+				results = append(results, searchResult{group: vl.title, title: p.Title(), detail: detail, contest: -1, list: l, site: i})
+			}
+		}
+	}
+	return results
+}
+
+// showSearch opens the search page, returning to the current page on esc.
+func (m model) showSearch() (model, tea.Cmd) {
+	m.searchReturn = m.currPage
+	m.currPage = searchPage
+	m.searchInput = textinput.New()
+	m.searchInput.Placeholder = m.t("Contests, candidates, ballot measures, places...")
+	m.searchInput.SetWidth(m.width - 6)
+	m.searchResults = nil
+	m.searchCursor = 0
+	return m, m.searchInput.Focus()
+}
+
+// openResult goes to the page a result lives on, with it selected.
+func (m model) openResult(r searchResult) (model, tea.Cmd) {
+	if r.contest >= 0 {
+		m = m.selectContest(r.contest)
+		if r.group != "Contests" {
+			// Candidates and ballot measures are only shown in full on the
+			// contest's own page
+			return m.openContest(), nil
+		}
+		m.currPage = contestsPage
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	places := m.voteLists()[r.list].places
+	if m.isExpired(places[r.site]) && !m.showExpired {
+		m.showExpired = true
+		cmd = m.setVoteListItems()
+	}
+	m.lm.SetActive(r.list)
+	for i, site := range m.siteOrder(places) {
+		if site == r.site {
+			m.lm.Select(i)
+		}
+	}
+	m.currPage = votePage
+	return m, cmd
+}
+
+func (m model) updateSearch(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok {
+		switch {
+		case key.Matches(keyMsg, m.keys.Back):
+			m.currPage = m.parentPage()
+			return m, nil
+		case key.Matches(keyMsg, m.keys.SearchUp):
+			m.searchCursor = max(0, m.searchCursor-1)
+			return m, nil
+		case key.Matches(keyMsg, m.keys.SearchDown):
+			m.searchCursor = max(0, min(len(m.searchResults)-1, m.searchCursor+1))
+			return m, nil
+		case key.Matches(keyMsg, m.keys.Details):
+			if m.searchCursor < len(m.searchResults) {
+				return m.openResult(m.searchResults[m.searchCursor])
+			}
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.searchInput, cmd = m.searchInput.Update(msg)
+	m.searchResults = m.search(m.searchInput.Value())
+	m.searchCursor = min(m.searchCursor, max(0, len(m.searchResults)-1))
+	return m, cmd
+}
+
+func (m model) viewSearch() string {
+	hintStyle := lipgloss.NewStyle().Foreground(m.theme.Muted).Render
+	selectedStyle := lipgloss.NewStyle().Foreground(m.theme.Accent).Bold(true).Render
+
+	// One line per group heading and per result, remembering which is selected
+	var lines []string
+	selected := 0
+	for i, r := range m.searchResults {
+		if i == 0 || m.searchResults[i-1].group != r.group {
+			count := 0
+			for _, other := range m.searchResults {
+				if other.group == r.group {
+					count++
+				}
+			}
+			lines = append(lines, m.theme.sectionTitle(fmt.Sprintf("%s (%d)", m.t(r.group), count)))
+		}
+		var detail string
+		if r.detail != "" {
+			detail = hintStyle(" — " + r.detail)
+		}
+		line := "  " + r.title + detail
+		if i == m.searchCursor {
+			selected = len(lines)
+			line = selectedStyle("> "+r.title) + detail
+		}
+		lines = append(lines, lipgloss.NewStyle().MaxWidth(m.width-2).Render(line))
+	}
+
+	var body string
+	switch {
+	case strings.TrimSpace(m.searchInput.Value()) == "":
+		body = hintStyle(m.t("Search contests, candidates, ballot measures and voting locations"))
+	case len(m.searchResults) == 0:
+		body = hintStyle(m.t("No matches"))
+	default:
+		// Scroll to keep the selected result on screen
+		height := max(1, m.height-9-m.bannerHeight())
+		start := max(0, selected-height+1)
+		body = strings.Join(lines[start:min(len(lines), start+height)], "\n")
+	}
+
+	return lipgloss.NewStyle().Margin(1, 1).MaxWidth(m.width).MaxHeight(m.height).Render(joinNonEmptyVertical(
+		lipgloss.Top,
+		m.HeaderView(),
+		m.searchInput.View(),
+		lipgloss.NewStyle().MarginTop(1).Render(body),
+		m.helpFooter(),
+	))
+}
@@ -1,14 +1,22 @@
 package tui
 
-import "charm.land/lipgloss/v2"
+import (
+	"io"
+
+	"charm.land/bubbles/v2/list"
+	"charm.land/lipgloss/v2"
+)
 
 func (m model) RenderErrorBox(text string) string {
 	const HEADER_HEIGHT = 3
+	if m.plain {
+		return lipgloss.NewStyle().MarginTop(1).Render(text)
+	}
 	return lipgloss.Place(
 		m.width, m.height-HEADER_HEIGHT, lipgloss.Center, lipgloss.Center,
 		lipgloss.NewStyle().
-			Foreground(lipgloss.Color("205")).
-			Background(lipgloss.Color("52")).
+			Foreground(m.theme.ErrorText).
+			Background(m.theme.ErrorBackground).
 			Bold(true).
 			Padding(1, 2).
 			Border(lipgloss.RoundedBorder()).
@@ -28,25 +36,6 @@ func (m model) renderPageError(message string) string {
 	)
 }
 
-func sectionTitleStyle(text string) string {
-	return lipgloss.NewStyle().
-		Foreground(lipgloss.Color("205")).
-		Bold(true).
-		Render(text)
-}
-
-func fieldLabelStyle(text string) string {
-	return lipgloss.NewStyle().
-		Foreground(lipgloss.Color("255")).
-		Render(text)
-}
-
-func fieldValueStyle(text string) string {
-	return lipgloss.NewStyle().
-		Foreground(lipgloss.Color("63")).
-		Render(text)
-}
-
 // joinNonEmptyVertical does a lipgloss.JoinVertical, but skips empty arguments (avoiding empty lines)
 func joinNonEmptyVertical(pos lipgloss.Position, items ...string) string {
 	nonEmptyItems := []string{}
@@ -57,3 +46,29 @@ func joinNonEmptyVertical(pos lipgloss.Position, items ...string) string {
 	}
 	return lipgloss.JoinVertical(pos, nonEmptyItems...)
 }
+
+// dimmable is a list item that can be greyed out, like a site that has
+// already closed.
+type dimmable interface {
+	dimmed() bool
+}
+
+// dimmingDelegate renders dimmed items in grey and everything else as usual.
+type dimmingDelegate struct {
+	list.DefaultDelegate
+	dim list.DefaultDelegate
+}
+
+func newDimmingDelegate(theme Theme) dimmingDelegate {
+	dim := list.NewDefaultDelegate()
+	dim.Styles = *theme.dimStyles()
+	return dimmingDelegate{DefaultDelegate: theme.defaultDelegate(), dim: dim}
+}
+
+func (d dimmingDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	if i, ok := item.(dimmable); ok && i.dimmed() {
+		d.dim.Render(w, m, index, item)
+		return
+	}
+	d.DefaultDelegate.Render(w, m, index, item)
+}
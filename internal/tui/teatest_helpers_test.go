@@ -3,11 +3,13 @@ package tui
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	tea "charm.land/bubbletea/v2"
 	"github.com/charmbracelet/colorprofile"
 	teatest "github.com/charmbracelet/x/exp/teatest/v2"
 	"github.com/govote-sh/govote/internal/api"
+	"github.com/govote-sh/govote/internal/domain"
 )
 
 // newTestProgram runs m as a full tea.Program against an in-memory 80x24
@@ -67,16 +69,17 @@ func fixtureVoterInfo() api.VoterInfoResponse {
 	}
 }
 
-// newVotePageModel builds a model as if a lookup just succeeded — it must
-// mirror the api.VoterInfoResponse branch in Update (tui.go): electionData,
-// hasMenu, both lists, currPage. If that branch changes, change this too.
+// fixtureNow is "today" for fixtureVoterInfo, two weeks before its election.
+func fixtureNow() time.Time {
+	return time.Date(2026, time.October, 20, 12, 0, 0, 0, time.Local)
+}
+
+// newVotePageModel builds a model as if a lookup just succeeded and the user
+// accepted the matched address — the same showResults step Update takes.
 func newVotePageModel(width, height int) model {
 	m := newModel(width, height)
-	data := fixtureVoterInfo()
+	m.now = fixtureNow
+	data, _ := domain.FromVoterInfo(fixtureVoterInfo())
 	m.electionData = &data
-	m.hasMenu = true
-	m.currPage = votePage
-	m.lm = m.InitVotePageListManager()
-	m.contestsList = m.InitContestsList()
-	return m
+	return m.showResults()
 }
@@ -0,0 +1,358 @@
+package tui
+
+import (
+	"image/color"
+
+	"charm.land/bubbles/v2/list"
+	tea "charm.land/bubbletea/v2"
+	huh "charm.land/huh/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/colorprofile"
+)
+
+// themeKey switches to the next theme on any page. Like languageKey it's a
+// control key so it can't be mistaken for typing in the address form.
+const themeKey = "ctrl+t"
+
+// Theme is the palette every page is drawn in. Pages take their colors from
+// the session's theme rather than naming them, so one can be swapped for
+// another mid-session.
+type Theme struct {
+	Name  string // As given to --theme
+	Label string // As shown to the user, in English
+
+	Accent   color.Color // Key hints, titles and the current tab
+	Text     color.Color // Labels and table cells
+	Value    color.Color // Field values
+	Muted    color.Color // Hints, other tabs and anything in the past
+	Faint    color.Color // Placeholders for what's missing
+	Warning  color.Color // Changed fields, special elections and state notices
+	Alert    color.Color // Local notices and arguments against a measure
+	Positive color.Color // Arguments for a measure
+
+	Measure       color.Color // Ballot measure titles in the contests list
+	MeasureDetail color.Color // and the lines under them
+
+	Item           color.Color // List items
+	ItemDetail     color.Color // and the lines under them
+	Selected       color.Color // The selected list item
+	SelectedDetail color.Color // and the lines under it
+
+	TitleText           color.Color // Page title bars, like the polling place's name
+	TitleBackground     color.Color
+	ListTitleText       color.Color // List titles
+	ListTitleBackground color.Color
+	ErrorText           color.Color // Error boxes
+	ErrorBackground     color.Color
+}
+
+var (
+	// defaultTheme is drawn for 256-color terminals with a dark background.
+	defaultTheme = Theme{
+		Name:  "default",
+		Label: "Default",
+
+		Accent:   lipgloss.Color("205"),
+		Text:     lipgloss.Color("255"),
+		Value:    lipgloss.Color("63"),
+		Muted:    lipgloss.Color("240"),
+		Faint:    lipgloss.Color("238"),
+		Warning:  lipgloss.Color("214"),
+		Alert:    lipgloss.Color("203"),
+		Positive: lipgloss.Color("42"),
+
+		Measure:       lipgloss.Color("214"),
+		MeasureDetail: lipgloss.Color("179"),
+
+		Item:           lipgloss.Color("#dddddd"),
+		ItemDetail:     lipgloss.Color("#777777"),
+		Selected:       lipgloss.Color("#EE6FF8"),
+		SelectedDetail: lipgloss.Color("#AD58B4"),
+
+		TitleText:           lipgloss.Color("205"),
+		TitleBackground:     lipgloss.Color("63"),
+		ListTitleText:       lipgloss.Color("230"),
+		ListTitleBackground: lipgloss.Color("62"),
+		ErrorText:           lipgloss.Color("205"),
+		ErrorBackground:     lipgloss.Color("52"),
+	}
+
+	// highContrastTheme keeps to the 16 colors every terminal has, in
+	// their brightest shades, and leaves text in the terminal's own color
+	// rather than greying it out.
+	highContrastTheme = Theme{
+		Name:  "high-contrast",
+		Label: "High contrast",
+
+		Accent:   lipgloss.Color("14"),
+		Text:     lipgloss.NoColor{},
+		Value:    lipgloss.Color("11"),
+		Muted:    lipgloss.NoColor{},
+		Faint:    lipgloss.NoColor{},
+		Warning:  lipgloss.Color("11"),
+		Alert:    lipgloss.Color("9"),
+		Positive: lipgloss.Color("10"),
+
+		Measure:       lipgloss.Color("11"),
+		MeasureDetail: lipgloss.NoColor{},
+
+		Item:           lipgloss.NoColor{},
+		ItemDetail:     lipgloss.NoColor{},
+		Selected:       lipgloss.Color("14"),
+		SelectedDetail: lipgloss.Color("14"),
+
+		TitleText:           lipgloss.Color("0"),
+		TitleBackground:     lipgloss.Color("14"),
+		ListTitleText:       lipgloss.Color("0"),
+		ListTitleBackground: lipgloss.Color("15"),
+		ErrorText:           lipgloss.Color("15"),
+		ErrorBackground:     lipgloss.Color("1"),
+	}
+
+	// lightTheme is the default theme in darker shades, for terminals
+	// with a light background.
+	lightTheme = Theme{
+		Name:  "light",
+		Label: "Light",
+
+		Accent:   lipgloss.Color("161"),
+		Text:     lipgloss.Color("235"),
+		Value:    lipgloss.Color("25"),
+		Muted:    lipgloss.Color("243"),
+		Faint:    lipgloss.Color("250"),
+		Warning:  lipgloss.Color("130"),
+		Alert:    lipgloss.Color("160"),
+		Positive: lipgloss.Color("28"),
+
+		Measure:       lipgloss.Color("130"),
+		MeasureDetail: lipgloss.Color("94"),
+
+		Item:           lipgloss.Color("#1a1a1a"),
+		ItemDetail:     lipgloss.Color("#A49FA5"),
+		Selected:       lipgloss.Color("161"),
+		SelectedDetail: lipgloss.Color("132"),
+
+		TitleText:           lipgloss.Color("255"),
+		TitleBackground:     lipgloss.Color("25"),
+		ListTitleText:       lipgloss.Color("255"),
+		ListTitleBackground: lipgloss.Color("62"),
+		ErrorText:           lipgloss.Color("160"),
+		ErrorBackground:     lipgloss.Color("224"),
+	}
+
+	// colorblindTheme draws from the Okabe-Ito palette, whose colors stay
+	// apart under the common kinds of color blindness. Nothing relies on
+	// telling red from green: arguments for a measure are blue and those
+	// against are orange.
+	colorblindTheme = Theme{
+		Name:  "colorblind",
+		Label: "Colorblind-safe",
+
+		Accent:   lipgloss.Color("#CC79A7"),
+		Text:     lipgloss.Color("255"),
+		Value:    lipgloss.Color("#56B4E9"),
+		Muted:    lipgloss.Color("245"),
+		Faint:    lipgloss.Color("240"),
+		Warning:  lipgloss.Color("#F0E442"),
+		Alert:    lipgloss.Color("#E69F00"),
+		Positive: lipgloss.Color("#56B4E9"),
+
+		Measure:       lipgloss.Color("#F0E442"),
+		MeasureDetail: lipgloss.Color("250"),
+
+		Item:           lipgloss.Color("#dddddd"),
+		ItemDetail:     lipgloss.Color("245"),
+		Selected:       lipgloss.Color("#CC79A7"),
+		SelectedDetail: lipgloss.Color("250"),
+
+		TitleText:           lipgloss.Color("255"),
+		TitleBackground:     lipgloss.Color("#0072B2"),
+		ListTitleText:       lipgloss.Color("255"),
+		ListTitleBackground: lipgloss.Color("#0072B2"),
+		ErrorText:           lipgloss.Color("255"),
+		ErrorBackground:     lipgloss.Color("#D55E00"),
+	}
+)
+
+// themes are the themes a session can pick from, in the order themeKey
+// cycles through them.
+var themes = []Theme{defaultTheme, highContrastTheme, lightTheme, colorblindTheme}
+
+// autoThemeName picks the theme that suits the terminal, and is where
+// themeKey's cycle starts and ends.
+const autoThemeName = "auto"
+
+// themeByName finds a theme by the name given to --theme.
+func themeByName(name string) (Theme, bool) {
+	for _, t := range themes {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return Theme{}, false
+}
+
+// autoTheme picks a theme for a terminal with the given color profile and
+// background. With 16 colors or fewer the default theme's greys fold into
+// black or white, so those terminals get high contrast.
+func autoTheme(profile colorprofile.Profile, dark bool) Theme {
+	switch {
+	case profile != colorprofile.Unknown && profile <= colorprofile.ANSI:
+		return highContrastTheme
+	case !dark:
+		return lightTheme
+	}
+	return defaultTheme
+}
+
+// withTheme starts a session in the theme with the given name, or picks one
+// to suit the terminal for "auto" or an empty name.
+func (m model) withTheme(name string) model {
+	if name == "" {
+		name = autoThemeName
+	}
+	m.themeName = name
+	m.theme = m.pickTheme()
+	m.spinner.Style = m.spinner.Style.Foreground(m.theme.Accent)
+	m.form = m.newAddressForm(m.input)
+	return m
+}
+
+// pickTheme is the theme the session asked for, or if it left it to us,
+// the one that suits its terminal.
+func (m model) pickTheme() Theme {
+	if t, ok := themeByName(m.themeName); ok {
+		return t
+	}
+	return autoTheme(m.profile, !m.lightBackground)
+}
+
+// setTheme switches to the theme with the given name, keeping the user's
+// place.
+func (m model) setTheme(name string) (model, tea.Cmd) {
+	m.themeName = name
+	m.theme = m.pickTheme()
+	m.spinner.Style = m.spinner.Style.Foreground(m.theme.Accent)
+	return m.redraw()
+}
+
+// nextTheme switches to the theme after the current one, then back to
+// picking one automatically.
+func (m model) nextTheme() (model, tea.Cmd) {
+	if m.themeName == autoThemeName {
+		return m.setTheme(themes[0].Name)
+	}
+	for i, t := range themes {
+		if t.Name == m.themeName && i+1 < len(themes) {
+			return m.setTheme(themes[i+1].Name)
+		}
+	}
+	return m.setTheme(autoThemeName)
+}
+
+// updateTerminal re-picks the theme as the terminal reports its colors, if
+// the session left the choice to us.
+func (m model) updateTerminal(msg tea.Msg) (model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.ColorProfileMsg:
+		m.profile = msg.Profile
+	case tea.BackgroundColorMsg:
+		m.lightBackground = !msg.IsDark()
+	}
+	if m.themeName != autoThemeName || m.pickTheme().Name == m.theme.Name {
+		return m, nil
+	}
+	return m.setTheme(autoThemeName)
+}
+
+// themeHint offers the next theme, and says which one is showing.
+func (m model) themeHint() string {
+	keyStyle := lipgloss.NewStyle().Foreground(m.theme.Accent).Render
+	hintStyle := lipgloss.NewStyle().Foreground(m.theme.Muted).Render
+	label := m.t(m.theme.Label)
+	if m.themeName == autoThemeName {
+		label = m.tf("Automatic (%s)", label)
+	}
+	return keyStyle("[Ctrl+T]") + hintStyle(" "+m.tf("Colors: %s", label))
+}
+
+// itemStyles are how lists draw their items.
+func (t Theme) itemStyles() list.DefaultItemStyles {
+	s := list.NewDefaultItemStyles(true)
+	s.NormalTitle = s.NormalTitle.Foreground(t.Item)
+	s.NormalDesc = s.NormalDesc.Foreground(t.ItemDetail)
+	s.SelectedTitle = s.SelectedTitle.Foreground(t.Selected).BorderForeground(t.SelectedDetail)
+	s.SelectedDesc = s.SelectedDesc.Foreground(t.SelectedDetail).BorderForeground(t.SelectedDetail)
+	s.DimmedTitle = s.DimmedTitle.Foreground(t.ItemDetail)
+	s.DimmedDesc = s.DimmedDesc.Foreground(t.Muted)
+	return s
+}
+
+// dimStyles grey out an item, like a site that has already closed.
+func (t Theme) dimStyles() *list.DefaultItemStyles {
+	s := t.itemStyles()
+	s.NormalTitle = s.NormalTitle.Foreground(t.Muted)
+	s.NormalDesc = s.NormalDesc.Foreground(t.Muted)
+	s.SelectedTitle = s.SelectedTitle.Foreground(t.Muted).BorderForeground(t.Muted)
+	s.SelectedDesc = s.SelectedDesc.Foreground(t.Muted).BorderForeground(t.Muted)
+	return &s
+}
+
+// defaultDelegate is bubbles' default list delegate in the theme's colors.
+func (t Theme) defaultDelegate() list.DefaultDelegate {
+	d := list.NewDefaultDelegate()
+	d.Styles = t.itemStyles()
+	return d
+}
+
+// styleList draws l's title and item count in the theme's colors.
+func (t Theme) styleList(l *list.Model) {
+	l.Styles.Title = l.Styles.Title.Foreground(t.ListTitleText).Background(t.ListTitleBackground)
+	l.Styles.StatusBar = l.Styles.StatusBar.Foreground(t.ItemDetail)
+	l.Styles.StatusBarActiveFilter = l.Styles.StatusBarActiveFilter.Foreground(t.Item)
+}
+
+// formStyles draws the address form in the theme's colors.
+func (t Theme) formStyles(isDark bool) *huh.Styles {
+	s := huh.ThemeCharm(isDark)
+	for _, f := range []*huh.FieldStyles{&s.Focused, &s.Blurred} {
+		f.Title = f.Title.Foreground(t.Accent)
+		f.Description = f.Description.Foreground(t.Muted)
+		f.ErrorIndicator = f.ErrorIndicator.Foreground(t.Alert)
+		f.ErrorMessage = f.ErrorMessage.Foreground(t.Alert)
+		f.FocusedButton = f.FocusedButton.Foreground(t.TitleText).Background(t.TitleBackground)
+		f.Next = f.FocusedButton
+		f.BlurredButton = f.BlurredButton.Foreground(t.Text).Background(t.Faint)
+		f.TextInput.Cursor = f.TextInput.Cursor.Foreground(t.Accent)
+		f.TextInput.Placeholder = f.TextInput.Placeholder.Foreground(t.Muted)
+		f.TextInput.Prompt = f.TextInput.Prompt.Foreground(t.Accent)
+		f.TextInput.Text = f.TextInput.Text.Foreground(t.Text)
+	}
+	s.Focused.Base = s.Focused.Base.BorderForeground(t.Muted)
+	s.Focused.Card = s.Focused.Base
+	s.Group.Title = s.Focused.Title
+	s.Group.Description = s.Focused.Description
+	return s
+}
+
+// sectionTitle draws a heading, like "Polling Hours".
+func (t Theme) sectionTitle(text string) string {
+	return lipgloss.NewStyle().
+		Foreground(t.Accent).
+		Bold(true).
+		Render(text)
+}
+
+// fieldLabel draws the name of a field, like "Address:".
+func (t Theme) fieldLabel(text string) string {
+	return lipgloss.NewStyle().
+		Foreground(t.Text).
+		Render(text)
+}
+
+// fieldValue draws a field's value.
+func (t Theme) fieldValue(text string) string {
+	return lipgloss.NewStyle().
+		Foreground(t.Value).
+		Render(text)
+}
@@ -0,0 +1,150 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"charm.land/bubbles/v2/list"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+// timelineItem is one dated step on the timeline page.
+type timelineItem struct {
+	date      time.Time // Sort key; zero for steps without a date, which come first
+	title     string
+	when      string // The date or window, e.g. "Tuesday, November 5, 2024"
+	countdown string // e.g. "in 12 days"
+	past      bool
+}
+
+func (i timelineItem) FilterValue() string { return i.title }
+func (i timelineItem) Title() string       { return i.title }
+func (i timelineItem) dimmed() bool        { return i.past }
+
+func (i timelineItem) Description() string {
+	if i.countdown == "" {
+		return i.when
+	}
+	return i.when + " · " + i.countdown
+}
+
+// timelineItems collects the election's deadlines and voting windows in
+// date order.
+func (m model) timelineItems() []timelineItem {
+	now := m.now()
+	var items []timelineItem
+
+	// The API has no registration deadline, so point at where to find it
+	for _, state := range m.electionData.States {
+		if state.Administration.RegistrationURL != "" {
+			items = append(items, timelineItem{
+				title: m.t("Register to vote"),
+				when:  m.tf("Check the deadline at %s", state.Administration.RegistrationURL),
+			})
+		}
+	}
+
+	// The early voting window runs from the first site opening to the last
+	// one closing
+	var earlyStart, earlyEnd time.Time
+	for _, p := range m.electionData.EarlyVoteSites {
+		if !p.StartDate.IsZero() && (earlyStart.IsZero() || p.StartDate.Before(earlyStart)) {
+			earlyStart = p.StartDate
+		}
+		if p.EndDate.After(earlyEnd) {
+			earlyEnd = p.EndDate
+		}
+	}
+	if !earlyStart.IsZero() {
+		items = append(items, timelineItem{
+			date:      earlyStart,
+			title:     m.t("Early voting opens"),
+			when:      formatDate(earlyStart, m.locale),
+			countdown: relativeDay(earlyStart, now, m.locale),
+			past:      daysUntil(earlyStart, now) < 0,
+		})
+	}
+	if !earlyEnd.IsZero() {
+		items = append(items, timelineItem{
+			date:      earlyEnd,
+			title:     m.t("Early voting ends"),
+			when:      formatDate(earlyEnd, m.locale),
+			countdown: relativeDay(earlyEnd, now, m.locale),
+			past:      daysUntil(earlyEnd, now) < 0,
+		})
+	}
+
+	for _, p := range m.electionData.DropOffLocations {
+		if p.StartDate.IsZero() && p.EndDate.IsZero() {
+			continue
+		}
+		item := timelineItem{
+			date:      p.StartDate,
+			title:     m.tf("Drop off a ballot at %s", p.Title()),
+			countdown: relativeWindow(p.StartDate, p.EndDate, now, m.locale),
+			past:      m.isExpired(p),
+		}
+		switch {
+		case p.StartDate.IsZero():
+			item.date = p.EndDate
+			item.when = m.tf("Until %s", formatDate(p.EndDate, m.locale))
+		case p.EndDate.IsZero() || p.StartDate.Equal(p.EndDate):
+			item.when = formatDate(p.StartDate, m.locale)
+		default:
+			item.when = fmt.Sprintf("%s → %s", formatDate(p.StartDate, m.locale), formatDate(p.EndDate, m.locale))
+		}
+		items = append(items, item)
+	}
+
+	if day := m.electionData.Election.Day; !day.IsZero() {
+		title := m.t("Election day")
+		if m.electionData.MailOnly {
+			title = m.t("Election day: last day to return your ballot")
+		}
+		items = append(items, timelineItem{
+			date:      day,
+			title:     title,
+			when:      formatDate(day, m.locale),
+			countdown: relativeDay(day, now, m.locale),
+			past:      daysUntil(day, now) < 0,
+		})
+	}
+
+	sort.SliceStable(items, func(i, j int) bool { return items[i].date.Before(items[j].date) })
+	return items
+}
+
+func (m model) InitTimelineList() *list.Model {
+	items := []list.Item{}
+	for _, item := range m.timelineItems() {
+		items = append(items, item)
+	}
+	model := list.New(items, newDimmingDelegate(m.theme), m.width, m.listHeight())
+	model.Title = m.t("Timeline")
+	m.searchable(&model)
+	m.plainList(&model, descriptionDelegate(m.theme))
+	return &model
+}
+
+func (m model) updateTimeline(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if m.timelineList != nil {
+		timelineList, cmd := m.timelineList.Update(msg)
+		m.timelineList = &timelineList
+		return m, cmd
+	}
+	return m, nil
+}
+
+func (m model) viewTimeline() string {
+	if m.timelineList == nil || len(m.timelineList.Items()) == 0 {
+		return m.renderPageError(m.t("No dates available for this election..."))
+	}
+	return lipgloss.NewStyle().Margin(1, 1).MaxWidth(m.width).MaxHeight(m.height).Render(lipgloss.JoinVertical(
+		lipgloss.Top,
+		m.HeaderView(),
+		m.timelineList.View(),
+		m.helpFooter(),
+	))
+}
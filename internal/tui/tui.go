@@ -1,22 +1,34 @@
 package tui
 
 import (
+	"context"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
 	"regexp"
 	"strings"
+	"time"
 
 	"charm.land/bubbles/v2/help"
+	"charm.land/bubbles/v2/key"
 	"charm.land/bubbles/v2/list"
 	spinner "charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/textinput"
+	"charm.land/bubbles/v2/viewport"
 	huh "charm.land/huh/v2"
 	"charm.land/log/v2"
 
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/colorprofile"
 	"github.com/charmbracelet/ssh"
 	"github.com/govote-sh/govote/internal/address"
 	"github.com/govote-sh/govote/internal/api"
+	"github.com/govote-sh/govote/internal/domain"
+	"github.com/govote-sh/govote/internal/i18n"
 	"github.com/govote-sh/govote/internal/listManager"
+	"github.com/govote-sh/govote/internal/store"
 	"github.com/govote-sh/govote/internal/utils"
 )
 
@@ -30,18 +42,75 @@ type model struct {
 	spinner spinner.Model
 
 	// Help menu
-	help help.Model
+	help         help.Model
+	keys         keyMap
+	keyOverrides []keyOverride // The user's, kept for rebuilding keys
+	copied       string        // Web address last clicked, shown in place of the help footer until the next key
+
+	locale i18n.Locale // Language the interface is shown in
+	plain  bool        // Plain mode, for screen readers
+
+	// Colors
+	theme           Theme
+	themeName       string               // Theme asked for, or autoThemeName to suit the terminal
+	profile         colorprofile.Profile // Colors the terminal can show
+	lightBackground bool
 
 	// Page
 	currPage page
 
+	// Input
+	input    address.InputAddress // The address most recently submitted
+	remember string               // Label to save input under once it's confirmed; "" to not save it
+	notice   string               // One-off message shown above the form, or elsewhere in place of the help footer
+	provider api.Provider         // Where lookups go
+
+	// Saved addresses, only available when the server has a store and the
+	// session authenticated with a public key
+	store     *store.Store
+	userID    string
+	savedList *list.Model // List for the saved address page; nil with nothing saved
+	deleting  *deletion   // Deletion waiting for the user to confirm it; nil if none
+
 	// Response
-	electionData *api.VoterInfoResponse
+	electionData *domain.VoterInfo
+	warnings     []domain.Warning // Values in the response that couldn't be used
+	showExpired  bool             // List sites whose window has ended
 	err          *utils.ErrMsg
+	now          func() time.Time // Clock for relative dates, swapped out in tests
+
+	// Election office notices: the banner can be dismissed, and the full
+	// text has its own page
+	noticesDismissed bool
+	noticeReturn     page // Page to go back to from the notice page
+	noticeView       viewport.Model
+
+	// Search page
+	searchInput   textinput.Model
+	searchResults []searchResult
+	searchCursor  int  // Index into searchResults
+	searchReturn  page // Page to go back to from the search page
+
+	referendumView viewport.Model // The selected ballot measure, on its own page
+
+	// Candidate comparison, on the contest page and the compare page
+	candidateCursor int          // Index into the contest's candidates
+	compareMarks    map[int]bool // Candidates marked to compare, by index
+	compareOffset   int          // First marked candidate shown when they don't all fit
 
 	// Lists
 	lm           *listManager.ListManager // List manager for the vote page
 	contestsList *list.Model              // List for the contests page
+	timelineList *list.Model              // List for the timeline page
+
+	// Contest sections the user folded, by contestSection.key
+	collapsedSections map[string]bool
+	primaryParty      string // Party whose primary ballot is shown; "" for every party
+
+	// Register page
+	registerPanel int            // Index into adminPanels
+	registerView  viewport.Model // The panel's scrollable details
+	officialsList *list.Model    // The panel's election officials
 
 	hasMenu bool
 
@@ -53,99 +122,351 @@ type page int
 
 const (
 	inputPage page = iota
+	savedAddressPage
 	loadingPage
 	reinputConfirmationPage
+	confirmAddressPage
 	votePage
 	contestsPage
 	contestContentPage
+	comparePage
+	referendumPage
 	registerPage
+	officialsPage
+	officialPage
+	timelinePage
 	pollingPlacePage
+	noticePage
+	searchPage
 )
 
-// createAddressForm creates the address input form with validation
-func createAddressForm() *huh.Form {
+// createAddressForm creates the address input form with validation. The
+// fields start out holding the values in defaults, so pass the zero
+// InputAddress for a blank form. A non-nil st adds an opt-in to remember the
+// address under a label, which is always off by default.
+func createAddressForm(defaults address.InputAddress, st *store.Store, loc i18n.Locale) *huh.Form {
+	fields := []huh.Field{
+		huh.NewInput().
+			Title(loc.T("Street Address")).
+			Key("street").
+			Value(&defaults.Street).
+			Placeholder("1234 W Broad St"),
+		huh.NewInput().
+			Title(loc.T("City")).
+			Key("city").
+			Value(&defaults.City).
+			Placeholder("Richmond"),
+		huh.NewInput().
+			Title(loc.T("State")).
+			Key("state").
+			Value(&defaults.State).
+			Placeholder("VA"),
+		huh.NewInput().
+			Title(loc.T("Postal Code")).
+			Key("postal_code").
+			Value(&defaults.PostalCode).
+			Placeholder("23220").
+			Validate(func(s string) error {
+				s = strings.TrimSpace(s)
+				if len(s) == 0 {
+					return nil // Allow empty
+				}
+				// Match 5 digits or 5+4 format (12345 or 12345-6789)
+				if !postalCodeRe.MatchString(s) {
+					return errors.New(loc.T("postal code must be 5 digits (e.g. 23220) or 9 digits (e.g. 23220-1234)"))
+				}
+				return nil
+			}),
+	}
+	if st == nil {
+		return huh.NewForm(huh.NewGroup(fields...)).WithKeyMap(formKeyMap(loc))
+	}
+
+	days := int(st.Retention().Hours() / 24)
+	remember := false
+	fields = append(fields, huh.NewConfirm().
+		Title(loc.T("Remember this address?")).
+		Description(loc.Tf("Stored encrypted and tied to your SSH key for %d days.\nDelete it any time with: ssh govote.sh forget", days)).
+		Key("remember").
+		Value(&remember).
+		Affirmative(loc.T("Yes")).
+		Negative(loc.T("No")))
 	return huh.NewForm(
+		huh.NewGroup(fields...),
 		huh.NewGroup(
 			huh.NewInput().
-				Title("Street Address").
-				Key("street").
-				Placeholder("1234 W Broad St"),
-			huh.NewInput().
-				Title("City").
-				Key("city").
-				Placeholder("Richmond"),
-			huh.NewInput().
-				Title("State").
-				Key("state").
-				Placeholder("VA"),
-			huh.NewInput().
-				Title("Postal Code").
-				Key("postal_code").
-				Placeholder("23220").
-				Validate(func(s string) error {
-					s = strings.TrimSpace(s)
-					if len(s) == 0 {
-						return nil // Allow empty
-					}
-					// Match 5 digits or 5+4 format (12345 or 12345-6789)
-					if !postalCodeRe.MatchString(s) {
-						return fmt.Errorf("postal code must be 5 digits (e.g. 23220) or 9 digits (e.g. 23220-1234)")
-					}
-					return nil
-				}),
-		),
-	)
+				Title(loc.T("Label")).
+				Description(loc.T("A name to find this address by later, like home or campus")).
+				Key("label").
+				Placeholder("home").
+				CharLimit(40),
+		).WithHideFunc(func() bool { return !remember }),
+	).WithKeyMap(formKeyMap(loc))
+}
+
+// formKeyMap is huh's default key map with its help in loc's language.
+func formKeyMap(loc i18n.Locale) *huh.KeyMap {
+	km := huh.NewDefaultKeyMap()
+	for _, b := range []*key.Binding{
+		&km.Quit,
+		&km.Input.Prev, &km.Input.Next, &km.Input.Submit, &km.Input.AcceptSuggestion,
+		&km.Confirm.Prev, &km.Confirm.Next, &km.Confirm.Submit, &km.Confirm.Toggle, &km.Confirm.Accept, &km.Confirm.Reject,
+	} {
+		translateHelp(b, loc)
+	}
+	return km
+}
+
+// translateHelp puts a key's help in loc's language. Bubbles and huh
+// describe their keys in English, which the catalogs translate like any
+// other message.
+func translateHelp(b *key.Binding, loc i18n.Locale) {
+	b.SetHelp(b.Help().Key, loc.T(b.Help().Desc))
+}
+
+// translateList puts the text a list draws itself, like its key help and
+// item count, in loc's language.
+func translateList(l *list.Model, loc i18n.Locale) {
+	km := &l.KeyMap
+	for _, b := range []*key.Binding{
+		&km.CursorUp, &km.CursorDown, &km.PrevPage, &km.NextPage, &km.GoToStart, &km.GoToEnd,
+		&km.Filter, &km.ClearFilter, &km.CancelWhileFiltering, &km.AcceptWhileFiltering,
+		&km.ShowFullHelp, &km.CloseFullHelp, &km.Quit, &km.ForceQuit,
+	} {
+		translateHelp(b, loc)
+	}
+	l.SetStatusBarItemName(loc.T("item"), loc.T("items"))
+	l.FilterInput.Prompt = loc.T("Filter: ")
+}
+
+// newAddressForm creates the address form for this session.
+func (m model) newAddressForm(defaults address.InputAddress) *huh.Form {
+	form := createAddressForm(defaults, m.rememberStore(), m.locale)
+	if m.plain {
+		return form.WithTheme(huh.ThemeFunc(plainFormTheme))
+	}
+	return form.WithTheme(huh.ThemeFunc(m.theme.formStyles))
+}
+
+// rememberStore returns the store if this session can save addresses.
+func (m model) rememberStore() *store.Store {
+	if m.userID == "" {
+		return nil
+	}
+	return m.store
+}
+
+// addressFieldKeys are the address form's field keys, in form order.
+var addressFieldKeys = []string{"street", "city", "state", "postal_code"}
+
+// focusAddressField moves an initialized address form's focus to the field
+// with the given key.
+func focusAddressField(form *huh.Form, key string) tea.Cmd {
+	var cmds []tea.Cmd
+	for _, k := range addressFieldKeys {
+		if k == key {
+			break
+		}
+		cmds = append(cmds, form.NextField())
+	}
+	return tea.Batch(cmds...)
 }
 
-// newModel constructs the initial model. Extracted from TeaHandler so tests
-// can build a model without an ssh.Session.
+// newModel constructs the initial model, in English. Extracted from
+// TeaHandler so tests can build a model without an ssh.Session.
 func newModel(width, height int) model {
 	spin := spinner.New(
 		spinner.WithSpinner(spinner.Dot),
-		spinner.WithStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("205"))),
+		spinner.WithStyle(lipgloss.NewStyle().Foreground(defaultTheme.Accent)),
 	)
 
 	return model{
-		form:     createAddressForm(),
-		spinner:  spin,
-		currPage: inputPage,
-		width:    width,
-		height:   height,
-		hasMenu:  false,
-		help:     help.New(),
+		form:      createAddressForm(address.InputAddress{}, nil, i18n.English),
+		spinner:   spin,
+		currPage:  inputPage,
+		width:     width,
+		height:    height,
+		hasMenu:   false,
+		help:      help.New(),
+		keys:      newKeyMap(i18n.English, nil),
+		provider:  api.CivicProvider{},
+		now:       time.Now,
+		locale:    i18n.English,
+		theme:     defaultTheme,
+		themeName: defaultTheme.Name,
 	}
 }
 
-func TeaHandler(s ssh.Session) (tea.Model, []tea.ProgramOption) {
-	pty, _, _ := s.Pty()
-	return newModel(pty.Window.Width, pty.Window.Height), nil
+// sessionOptions are what a session starts out with, as asked for by the
+// client.
+type sessionOptions struct {
+	locale i18n.Locale
+	plain  bool
+	theme  string // A theme's name; "" for one that suits the terminal
+	keys   string // Key overrides, as parseKeyOverrides reads them
+}
+
+// parseSessionOptions reads the session's options from the arguments to
+// the ssh command and the environment the client sent. The language is a
+// --lang argument, or else the locale variables, or English. Plain mode is
+// a --plain argument, ACCESSIBLE set to anything, or a dumb terminal. The
+// theme is a --theme argument, or else GOVOTE_THEME, or one that suits the
+// terminal. Keys are rebound by a --keys argument, or else GOVOTE_KEYS.
+func parseSessionOptions(environ, args []string) sessionOptions {
+	flags := flag.NewFlagSet("govote", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	lang := flags.String("lang", "", "Language, e.g. es")
+	plain := flags.Bool("plain", false, "Plain mode, for screen readers")
+	theme := flags.String("theme", "", "Color theme, e.g. high-contrast")
+	keys := flags.String("keys", "", "Key overrides, e.g. vim,contests=c 2")
+	if err := flags.Parse(args); err != nil {
+		log.Warn("Ignoring unreadable session arguments", "args", args, "error", err)
+	}
+
+	opts := sessionOptions{locale: i18n.FromEnv(environ), plain: *plain}
+	if *lang != "" {
+		if l, ok := i18n.Parse(*lang); ok {
+			opts.locale = l
+		} else {
+			log.Warn("Unsupported language requested", "lang", *lang)
+		}
+	}
+	themeName := *theme
+	for _, kv := range environ {
+		name, value, _ := strings.Cut(kv, "=")
+		if (name == "ACCESSIBLE" && value != "") || kv == "TERM=dumb" {
+			opts.plain = true
+		}
+		if name == "GOVOTE_THEME" && themeName == "" {
+			themeName = value
+		}
+		if name == "GOVOTE_KEYS" && *keys == "" {
+			*keys = value
+		}
+	}
+	if themeName != "" && themeName != autoThemeName {
+		if _, ok := themeByName(themeName); ok {
+			opts.theme = themeName
+		} else {
+			log.Warn("Unknown theme requested", "theme", themeName)
+		}
+	}
+	if *keys != "" {
+		overrides, err := parseKeyOverrides(*keys)
+		if err != nil {
+			log.Warn("Ignoring unreadable key overrides", "keys", *keys, "error", err)
+		}
+		if conflicts := newKeyMap(i18n.English, overrides).conflicts(); len(conflicts) > 0 {
+			log.Warn("Key overrides bind keys twice", "keys", *keys, "conflicts", conflicts)
+		}
+		opts.keys = *keys
+	}
+	return opts
+}
+
+// NewTeaHandler returns the wish handler that builds each session's model.
+// Lookups go to provider; st may be nil, which disables remembering
+// addresses.
+func NewTeaHandler(provider api.Provider, st *store.Store) func(ssh.Session) (tea.Model, []tea.ProgramOption) {
+	return func(s ssh.Session) (tea.Model, []tea.ProgramOption) {
+		pty, _, _ := s.Pty()
+		opts := parseSessionOptions(s.Environ(), s.Command())
+		m := newModel(pty.Window.Width, pty.Window.Height).withTheme(opts.theme).withPlain(opts.plain).withLocale(opts.locale).withKeys(opts.keys)
+		m.provider = provider
+		if st != nil && s.PublicKey() != nil {
+			m = m.withStore(st, st.UserID(s.PublicKey()))
+		}
+		return m, nil
+	}
+}
+
+// withStore lets the session remember addresses under userID, starting on
+// the saved address page if the user already has any.
+func (m model) withStore(st *store.Store, userID string) model {
+	m.store = st
+	m.userID = userID
+	m.form = m.newAddressForm(address.InputAddress{})
+
+	entry, err := st.Load(userID)
+	if err != nil {
+		if !errors.Is(err, store.ErrNotFound) {
+			log.Error("Failed to load saved address", "error", err)
+		}
+		return m
+	}
+	m = m.withSavedAddresses(entry.Addresses)
+	m.currPage = savedAddressPage
+	return m
 }
 
 func (m model) Init() tea.Cmd {
 	if m.form == nil {
-		return nil
+		return tea.RequestBackgroundColor
 	}
-	return m.form.Init()
+	return tea.Batch(m.form.Init(), tea.RequestBackgroundColor)
 }
 
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// The language, plain mode and theme toggles work on every page, even
+	// over the form, and so does ctrl+c
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok {
+		switch {
+		case key.Matches(keyMsg, m.keys.Language):
+			return m.toggleLocale()
+		case key.Matches(keyMsg, m.keys.Plain):
+			return m.togglePlain()
+		case key.Matches(keyMsg, m.keys.Theme):
+			return m.nextTheme()
+		case key.Matches(keyMsg, m.keys.ForceQuit):
+			return m, tea.Quit
+		}
+	}
+	switch msg.(type) {
+	case tea.ColorProfileMsg, tea.BackgroundColorMsg:
+		return m.updateTerminal(msg)
+	case tea.MouseClickMsg, tea.MouseWheelMsg:
+		if next, cmd, handled := m.updateMouse(msg); handled {
+			return next, cmd
+		}
+	case tea.KeyPressMsg:
+		m.copied = ""
+		if m.currPage != inputPage {
+			// Seen in the help footer; the form's stays until the next lookup
+			m.notice = ""
+		}
+	}
+
 	var headerCmd tea.Cmd
+	prevPage, showingHelp := m.currPage, m.help.ShowAll
 	m, headerCmd = m.HeaderUpdate(msg)
+	if _, isKey := msg.(tea.KeyPressMsg); m.currPage != prevPage || (isKey && (showingHelp || m.help.ShowAll)) {
+		// The key went to the header, not the page it opened or the one
+		// under the help
+		return m, headerCmd
+	}
 	cmds := []tea.Cmd{headerCmd}
 
 	switch msg := msg.(type) {
+	case savedAddressesMsg:
+		return m.withSavedAddresses(msg), nil
+	case forgottenMsg:
+		m = m.withSavedAddresses(nil)
+		m.notice = "Your saved addresses have been deleted."
+		if m.currPage != savedAddressPage {
+			return m, nil
+		}
+		m.form = m.newAddressForm(address.InputAddress{})
+		m.currPage = inputPage
+		return m, m.form.Init()
+	case storeErrMsg:
+		log.Error("Failed to update saved addresses", "error", msg.err)
+		m.notice = storeNotice(msg.err)
+		return m, nil
 	case tea.WindowSizeMsg:
 		// Capture the window size
 		m.width = msg.Width
 		m.height = msg.Height
-
-		if m.lm != nil {
-			m.lm.SetSize(m.width, m.height-4)
-		}
-		if m.contestsList != nil {
-			m.contestsList.SetSize(m.width, m.height-4)
-		}
-		return m, nil
+		return m.resizeLists(), nil
 	}
 
 	var next tea.Model = m
@@ -169,23 +490,11 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				PostalCode: strings.TrimSpace(m.form.GetString("postal_code")),
 			}
 
-			// Require at least one non-empty field
-			if addr.IsEmpty() {
-				m.err = &utils.ErrMsg{Err: fmt.Errorf("at least one address field is required")}
-				m.currPage = reinputConfirmationPage
-				return m, nil
+			m.remember = ""
+			if m.form.GetBool("remember") {
+				m.remember = labelOrDefault(m.form.GetString("label"), addr)
 			}
-
-			// Set the next page or state, such as loading page
-			m.currPage = loadingPage
-
-			// Return the CheckServer call as a tea.Cmd
-			return m, tea.Batch(
-				m.spinner.Tick,
-				func() tea.Msg {
-					return api.CheckServer(addr)
-				},
-			)
+			return m.lookup(addr)
 		case huh.StateAborted:
 			return m, tea.Quit
 		}
@@ -193,13 +502,18 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Handle the server response
 		switch msg := msg.(type) {
 		case api.VoterInfoResponse:
-			// Save the response and move to the votePage
-			m.electionData = &msg
-			m.currPage = votePage
-			m.hasMenu = true
-			m.lm = m.InitVotePageListManager()
-			m.contestsList = m.InitContestsList()
-
+			// Save the response and have the user confirm the address the
+			// API matched before showing any results for it
+			data, warnings := domain.FromVoterInfo(msg)
+			for _, w := range warnings {
+				log.Warn("Ignoring unexpected value in API response", "warning", w)
+			}
+			m.electionData = &data
+			m.warnings = warnings
+			if data.NormalizedInput == (domain.Address{}) {
+				return m.acceptResults()
+			}
+			m.currPage = confirmAddressPage
 			return m, nil
 
 		case spinner.TickMsg:
@@ -215,85 +529,257 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 	case reinputConfirmationPage:
-		// Wait for any key press to continue
-		if _, ok := msg.(tea.KeyPressMsg); ok {
-			// Reset the form and return to input state
-			m.form = createAddressForm()
-			m.err = nil
-			m.currPage = inputPage
-			return m, m.form.Init()
-		}
+		next, pageCmd = m.updateReinputConfirmation(msg)
+	case savedAddressPage:
+		next, pageCmd = m.updateSavedAddress(msg)
+	case confirmAddressPage:
+		next, pageCmd = m.updateConfirmAddress(msg)
 	case votePage:
 		next, pageCmd = m.UpdateVote(msg)
 	case contestsPage:
 		next, pageCmd = m.updateContests(msg)
 	case contestContentPage:
 		next, pageCmd = m.updateContestContent(msg)
+	case comparePage:
+		next, pageCmd = m.updateCompare(msg)
+	case referendumPage:
+		next, pageCmd = m.updateReferendum(msg)
 	case registerPage:
-		// Header handles v/c/r/q; no page-specific keys yet.
+		next, pageCmd = m.updateRegister(msg)
+	case officialsPage:
+		next, pageCmd = m.updateOfficials(msg)
+	case officialPage:
+		next, pageCmd = m.updateOfficial(msg)
+	case timelinePage:
+		next, pageCmd = m.updateTimeline(msg)
 	case pollingPlacePage:
 		next, pageCmd = m.updatePollingPlace(msg)
+	case noticePage:
+		next, pageCmd = m.updateNotice(msg)
+	case searchPage:
+		next, pageCmd = m.updateSearch(msg)
 	}
 
 	cmds = append(cmds, pageCmd)
 	return next, tea.Batch(cmds...)
 }
 
+// lookup starts fetching election information for addr.
+func (m model) lookup(addr address.InputAddress) (model, tea.Cmd) {
+	m.input = addr
+	m.notice = ""
+
+	// Require at least one non-empty field
+	if addr.IsEmpty() {
+		m.err = &utils.ErrMsg{Err: fmt.Errorf("at least one address field is required")}
+		m.currPage = reinputConfirmationPage
+		return m, nil
+	}
+
+	// Set the next page or state, such as loading page
+	m.currPage = loadingPage
+
+	// Return the lookup as a tea.Cmd
+	provider := m.provider
+	return m, tea.Batch(
+		m.spinner.Tick,
+		func() tea.Msg {
+			return api.Msg(provider.VoterInfo(context.Background(), addr))
+		},
+	)
+}
+
+// acceptResults shows the results for the looked-up address and, if the
+// user opted in on the form, saves the address for next time.
+func (m model) acceptResults() (model, tea.Cmd) {
+	m = m.showResults()
+	st := m.rememberStore()
+	if m.remember == "" || st == nil {
+		return m, nil
+	}
+
+	label, addr, userID := m.remember, m.input, m.userID
+	m.remember = ""
+	return m, func() tea.Msg {
+		entry, err := st.Save(userID, label, addr)
+		if err != nil {
+			return storeErrMsg{err}
+		}
+		return savedAddressesMsg(entry.Addresses)
+	}
+}
+
+// showResults moves from the lookup flow to the results pages, building the
+// lists from electionData.
+func (m model) showResults() model {
+	m.currPage = votePage
+	m.hasMenu = true
+	m.lm = m.InitVotePageListManager()
+	m.collapsedSections = map[string]bool{}
+	m.primaryParty = ""
+	m.contestsList = m.InitContestsList()
+	m.timelineList = m.InitTimelineList()
+	m.noticesDismissed = false
+	m.registerPanel = 0
+	return m.resizeLists()
+}
+
+// redraw rebuilds whatever's open after the language or plain mode
+// changes, without losing the user's place: what's typed in the form,
+// which list is showing and what's selected in each.
+func (m model) redraw() (model, tea.Cmd) {
+	var cmd tea.Cmd
+	if m.currPage == inputPage && m.form != nil {
+		values, focused := formValues(m)
+		m.form = m.newAddressForm(values)
+		cmd = tea.Batch(m.form.Init(), focusAddressField(m.form, focused))
+	}
+
+	if m.savedList != nil {
+		entries := make([]store.SavedAddress, 0, len(m.savedList.Items()))
+		for _, item := range m.savedList.Items() {
+			entries = append(entries, store.SavedAddress(item.(savedAddressItem)))
+		}
+		m = m.withSavedAddresses(entries)
+	}
+
+	if m.electionData == nil || m.lm == nil {
+		return m, cmd
+	}
+	active, site := m.lm.Active(), m.lm.ActiveList().Index()
+	contest, step := m.contestsList.Index(), m.timelineList.Index()
+	official := -1
+	if m.officialsList != nil {
+		official = m.officialsList.Index()
+	}
+
+	m.lm = m.InitVotePageListManager()
+	m.lm.SetActive(active)
+	m.lm.Select(site)
+	m.contestsList = m.InitContestsList()
+	m.contestsList.Select(contest)
+	m.timelineList = m.InitTimelineList()
+	m.timelineList.Select(step)
+	m = m.resizeLists()
+	if official >= 0 {
+		m.officialsList.Select(official)
+	}
+	if m.currPage == searchPage {
+		m.searchInput.Placeholder = m.t("Contests, candidates, ballot measures, places...")
+	}
+	return m, cmd
+}
+
+// listWidth is the width of the lists that size their items to fit: the
+// window, less the page's margins.
+func (m model) listWidth() int {
+	return max(1, m.width-2)
+}
+
+// listHeight is the height left for a results page's list under the header
+// and any notice banner, and over the help footer.
+func (m model) listHeight() int {
+	header := m.tabBar()
+	if m.plain {
+		header = m.plainHeader()
+	}
+	// The page's margins, and the footer with its gap
+	return m.height - lipgloss.Height(header) - m.bannerHeight() - 4
+}
+
+// resizeLists fits the lists and scrolling views to the window, below the
+// header and any notice banner.
+func (m model) resizeLists() model {
+	if m.lm != nil {
+		m.lm.SetSize(m.listWidth(), m.voteListHeight())
+	}
+	if m.contestsList != nil {
+		m.contestsList.SetSize(m.listWidth(), m.contestsListHeight())
+		m = m.withReferendumView()
+	}
+	if m.timelineList != nil {
+		m.timelineList.SetSize(m.width, m.listHeight())
+	}
+	if m.electionData != nil {
+		m = m.withRegisterPanel(m.registerPanel)
+		m = m.withNoticeView()
+	}
+	if m.savedList != nil {
+		m.savedList.SetSize(m.width, m.height-4)
+	}
+	return m
+}
+
 func (m model) View() tea.View {
+	body := m.viewPage()
+	if m.help.ShowAll {
+		body = m.viewHelp()
+	}
+	return tea.View{Content: body, AltScreen: !m.plain, MouseMode: m.mouseMode()}
+}
+
+// viewPage draws the current page.
+func (m model) viewPage() string {
 	var body string
 	switch m.currPage {
 	case inputPage:
 		body = m.viewInput()
+	case savedAddressPage:
+		body = m.viewSavedAddress()
 	case loadingPage:
-		body = fmt.Sprintf("%s Loading election information, please wait...\n\n", m.spinner.View())
+		if m.plain {
+			// A spinner would have a screen reader announce every frame
+			body = m.t("Loading election information, please wait...") + "\n\n"
+		} else {
+			body = fmt.Sprintf("%s %s\n\n", m.spinner.View(), m.t("Loading election information, please wait..."))
+		}
 	case reinputConfirmationPage:
 		body = m.viewReinputConfirmation()
+	case confirmAddressPage:
+		body = m.viewConfirmAddress()
 	case votePage:
 		body = m.viewVote()
 	case contestsPage:
 		body = m.viewContests()
 	case contestContentPage:
 		body = m.viewContestContent()
+	case comparePage:
+		body = m.viewCompare()
+	case referendumPage:
+		body = m.viewReferendum()
 	case registerPage:
 		body = m.viewRegister()
+	case officialsPage:
+		body = m.viewOfficials()
+	case officialPage:
+		body = m.viewOfficial()
+	case timelinePage:
+		body = m.viewTimeline()
 	case pollingPlacePage:
 		body = m.viewPollingPlace()
+	case noticePage:
+		body = m.viewNotice()
+	case searchPage:
+		body = m.viewSearch()
 	}
-	return tea.View{Content: body, AltScreen: true}
-}
-
-func (m model) viewReinputConfirmation() string {
-	var errorMsg string
-	if m.err == nil {
-		errorMsg = "Error: unknown error"
-	} else if m.err.HTTPStatusCode >= 400 && m.err.HTTPStatusCode < 500 { // Client error
-		errorMsg = fmt.Sprintf("Error: Client error (code: %d): This is likely due to an invalid address\nor the voter information project not being up to date\nPlease check https://all.votinginfotool.org", m.err.HTTPStatusCode)
-	} else if m.err.HTTPStatusCode >= 500 && m.err.HTTPStatusCode < 600 { // Server error
-		errorMsg = fmt.Sprintf("Error: Server error (code: %d): This is likely due to the API being down\nPlease check https://all.votinginfotool.org to make sure", m.err.HTTPStatusCode)
-	} else {
-		log.Error(m.err.Err)
-		errorMsg = fmt.Sprintf("Error: %v", m.err.Err.Error())
-	}
-
-	return lipgloss.NewStyle().Margin(1, 1).MaxWidth(m.width).MaxHeight(m.height).Render(lipgloss.JoinVertical(
-		lipgloss.Top,
-		errorMsg,
-		"Press any key to continue...",
-	))
+	return body
 }
 
 func (m model) viewInput() string {
 	headerStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("205")).
+		Foreground(m.theme.Accent).
 		Align(lipgloss.Center).
 		Bold(true).
 		Padding(0, 1)
 
 	subtitleStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("255")).
+		Foreground(m.theme.Text).
 		Align(lipgloss.Center).
 		Padding(0, 1)
-	header := headerStyle.Render("Welcome to govote.sh!")
-	subtitle := subtitleStyle.Render(utils.Wrap("Please enter your address to get election information from the Voting Information Project", m.width-4))
-	return fmt.Sprintf("%s\n%s\n\n%s", header, subtitle, m.form.View())
+	header := headerStyle.Render(m.t("Welcome to govote.sh!"))
+	subtitle := subtitleStyle.Render(utils.Wrap(m.t("Please enter your address to get election information from the Voting Information Project"), m.width-4))
+	if m.notice != "" {
+		subtitle += "\n\n" + m.theme.fieldValue(m.t(m.notice))
+	}
+	return fmt.Sprintf("%s\n%s\n\n%s\n\n%s   %s\n%s", header, subtitle, m.form.View(), m.languageHint(), m.plainHint(), m.themeHint())
 }
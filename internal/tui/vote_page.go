@@ -1,13 +1,69 @@
 package tui
 
 import (
+	"fmt"
+	"image/color"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
 	"charm.land/bubbles/v2/list"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
-	"github.com/govote-sh/govote/internal/api"
+	"github.com/govote-sh/govote/internal/domain"
 	"github.com/govote-sh/govote/internal/listManager"
 )
 
+// expiredPlace is a site whose window has already ended. Expired sites are
+// only listed when the user asks to see them, and are greyed out.
+type expiredPlace struct {
+	domain.PollingPlace
+	closed string // When it closed, e.g. "Closed 3 days ago"
+}
+
+func (p expiredPlace) Description() string {
+	return p.closed + " · " + p.PollingPlace.Description()
+}
+
+func (p expiredPlace) dimmed() bool { return true }
+
+// pollingPlaceItem unwraps a site listed on the vote page.
+func pollingPlaceItem(item list.Item) (domain.PollingPlace, bool) {
+	switch p := item.(type) {
+	case domain.PollingPlace:
+		return p, true
+	case expiredPlace:
+		return p.PollingPlace, true
+	}
+	return domain.PollingPlace{}, false
+}
+
+// newSiteDelegate lists each site with its address and when it's open,
+// greying out the ones that have closed.
+func (m model) newSiteDelegate() detailDelegate {
+	now, loc := m.now, m.locale
+	d := newDetailDelegate(3, m.theme, func(item list.Item) []string {
+		switch p := item.(type) {
+		case expiredPlace:
+			return []string{p.Address.String(), p.closed}
+		case domain.PollingPlace:
+			when := relativeWindow(p.StartDate, p.EndDate, now(), loc)
+			if when == "" {
+				when, _, _ = strings.Cut(strings.TrimSpace(p.Hours), "\n")
+			}
+			return []string{p.Address.String(), when}
+		}
+		return nil
+	})
+	dim := m.theme.dimStyles()
+	d.styles = func(item list.Item) *list.DefaultItemStyles {
+		if i, ok := item.(dimmable); ok && i.dimmed() {
+			return dim
+		}
+		return nil
+	}
+	return d
+}
+
 func (m model) UpdateVote(msg tea.Msg) (tea.Model, tea.Cmd) {
 	if m.lm != nil {
 		var cmd tea.Cmd
@@ -18,20 +74,19 @@ func (m model) UpdateVote(msg tea.Msg) (tea.Model, tea.Cmd) {
 	}
 
 	if keyMsg, ok := msg.(tea.KeyPressMsg); ok {
-		switch keyMsg.String() {
-		case "q", "ctrl+c":
-			return m, tea.Quit
-		case "enter":
-			_, ok := m.lm.SelectedItem().(api.PollingPlace)
-			if ok {
-				m.currPage = pollingPlacePage
+		switch {
+		case key.Matches(keyMsg, m.keys.Details):
+			return m.openSelected()
+		case key.Matches(keyMsg, m.keys.ShowClosed):
+			if m.lm != nil && m.expiredSites() > 0 {
+				m.showExpired = !m.showExpired
+				return m, m.setVoteListItems()
 			}
-			return m, nil
-		case "tab":
+		case key.Matches(keyMsg, m.keys.NextList):
 			if m.lm != nil {
 				m.lm.CycleNext()
 			}
-		case "shift+tab":
+		case key.Matches(keyMsg, m.keys.PrevList):
 			if m.lm != nil {
 				m.lm.CyclePrev()
 			}
@@ -43,43 +98,231 @@ func (m model) UpdateVote(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 func (m model) viewVote() string {
 	if m.lm == nil {
-		return "building list..."
+		return m.t("building list...")
 	}
-	return lipgloss.NewStyle().Margin(1, 1).MaxWidth(m.width).MaxHeight(m.height).Render(lipgloss.JoinVertical(
+	return lipgloss.NewStyle().Margin(1, 1).MaxWidth(m.width).MaxHeight(m.height).Render(joinNonEmptyVertical(
 		lipgloss.Top,
-		m.HeaderView(),
-		lipgloss.NewStyle().Foreground(lipgloss.Color("63")).MarginLeft(3).Render("Use tab to cycle through the lists of voting options"),
+		m.voteHeading(),
 		m.lm.ActiveList().View(),
+		m.helpFooter(),
 	))
 }
 
-func (m model) InitVotePageListManager() *listManager.ListManager {
-	// Type conversions
-	var pollingLocationItems, earlyVoteItems, dropOffItems []list.Item
+// voteHeading is what the vote page draws above its lists.
+func (m model) voteHeading() string {
+	hintStyle := lipgloss.NewStyle().Foreground(m.theme.Value).MarginLeft(3).Render
+	return joinNonEmptyVertical(
+		lipgloss.Top,
+		m.HeaderView(),
+		m.electionSummary(),
+		m.voteNotices(),
+		hintStyle(m.t("Use tab to cycle through the lists of voting options")),
+	)
+}
 
-	for _, pollingPlace := range m.electionData.PollingLocations {
-		pollingLocationItems = append(pollingLocationItems, pollingPlace)
+// electionSummary is the election's name and day, e.g. "General Election ·
+// Tuesday, November 5, 2024 (in 12 days)".
+func (m model) electionSummary() string {
+	election := m.electionData.Election
+	summary := m.theme.sectionTitle(election.Name)
+	if !election.Day.IsZero() {
+		summary += m.theme.fieldValue(fmt.Sprintf(" · %s (%s)", formatDate(election.Day, m.locale), relativeDay(election.Day, m.now(), m.locale)))
 	}
+	return lipgloss.NewStyle().MarginLeft(3).Render(summary)
+}
 
-	for _, earlyVoteSite := range m.electionData.EarlyVoteSites {
-		earlyVoteItems = append(earlyVoteItems, earlyVoteSite)
+// voteNotices are the messages shown between the election summary and the
+// lists, if any: that the election is over or by mail, and that some data
+// was dropped.
+func (m model) voteNotices() string {
+	var notices []string
+
+	if m.electionData.MailOnly && !m.electionOver() {
+		notices = append(notices, m.noticeBox(m.theme.Value, m.mailOnlyLines()))
 	}
 
-	for _, dropOffLocation := range m.electionData.DropOffLocations {
-		dropOffItems = append(dropOffItems, dropOffLocation)
+	if m.electionOver() {
+		lines := []string{m.t("This election is over.")}
+		if others := m.electionData.OtherElections; len(others) > 0 {
+			lines = append(lines, m.t("The Voting Information Project also has information on:"))
+			for _, e := range others {
+				line := "• " + e.Name
+				if !e.Day.IsZero() {
+					line += fmt.Sprintf(" (%s, %s)", formatDate(e.Day, m.locale), relativeDay(e.Day, m.now(), m.locale))
+				}
+				lines = append(lines, line)
+			}
+		} else {
+			lines = append(lines, m.t("Check back closer to the next election for updated information."))
+		}
+		notices = append(notices, m.noticeBox(m.theme.Warning, lines))
 	}
 
-	return listManager.InitListManager(
-		[][]list.Item{
-			pollingLocationItems,
-			earlyVoteItems,
-			dropOffItems,
-		},
-		[]string{
-			"Polling Locations",
-			"Early Voting Sites",
-			"Drop Off Locations",
-		},
-		m.width, m.height-4,
-	)
+	if len(m.warnings) > 0 {
+		notices = append(notices, lipgloss.NewStyle().Foreground(m.theme.Muted).MarginLeft(3).
+			Render(m.t("Some details from the Voting Information Project could not be read and are not shown.")))
+	}
+
+	return joinNonEmptyVertical(lipgloss.Top, notices...)
+}
+
+// noticeBox draws lines in a colored box that fits the page. Plain mode
+// leaves out the box.
+func (m model) noticeBox(color color.Color, lines []string) string {
+	style := lipgloss.NewStyle().Foreground(color).MarginLeft(3).Width(m.width - 6)
+	if !m.plain {
+		style = style.Border(lipgloss.RoundedBorder()).BorderForeground(color).Padding(0, 1)
+	}
+	return style.Render(strings.Join(lines, "\n"))
+}
+
+// mailOnlyLines explain how voting by mail works for this election.
+// Postmark and receipt deadlines vary by state, and the API doesn't give
+// them, so they point at the state's own pages instead.
+func (m model) mailOnlyLines() []string {
+	lines := []string{m.t("This election is held by mail: a ballot is mailed to every registered voter.")}
+	if day := m.electionData.Election.Day; !day.IsZero() {
+		lines = append(lines, m.tf("Return it by mail or at a drop-off location by %s (%s).", formatDate(day, m.locale), relativeDay(day, m.now(), m.locale)))
+	} else {
+		lines = append(lines, m.t("Return it by mail or at a drop-off location by election day."))
+	}
+	lines = append(lines, m.t("Mailed ballots may have to be postmarked or received by a deadline; check the rules:"))
+
+	// Local offices know their own deadlines best, so they come first
+	var admins []domain.Administration
+	for _, state := range m.electionData.States {
+		if state.LocalJurisdiction != nil {
+			admins = append(admins, state.LocalJurisdiction.Administration)
+		}
+		admins = append(admins, state.Administration)
+	}
+	seen := map[string]bool{}
+	for _, admin := range admins {
+		for _, link := range []struct{ label, url string }{
+			{"Absentee voting", admin.AbsenteeVotingInfoURL},
+			{"Ballot info", admin.BallotInfoURL},
+		} {
+			if link.url != "" && !seen[link.url] {
+				seen[link.url] = true
+				lines = append(lines, fmt.Sprintf("• %s: %s", m.t(link.label), link.url))
+			}
+		}
+	}
+	return lines
+}
+
+// electionOver reports whether the election day has passed.
+func (m model) electionOver() bool {
+	day := m.electionData.Election.Day
+	return !day.IsZero() && daysUntil(day, m.now()) < 0
+}
+
+// voteListHeight leaves room above the vote page's lists for the header,
+// the election summary, any notices and the hint line, and below them for
+// the help footer.
+func (m model) voteListHeight() int {
+	// Less the summary and hint lines
+	height := m.listHeight() - 2
+	if notices := m.voteNotices(); notices != "" {
+		height -= lipgloss.Height(notices)
+	}
+	return height
+}
+
+// isExpired reports whether a site's window ended before today.
+func (m model) isExpired(p domain.PollingPlace) bool {
+	return !p.EndDate.IsZero() && daysUntil(p.EndDate, m.now()) < 0
+}
+
+// expiredSites counts the sites hidden unless showExpired is set.
+func (m model) expiredSites() int {
+	n := 0
+	for _, l := range m.voteLists() {
+		for _, p := range l.places {
+			if m.isExpired(p) {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// voteList is one of the vote page's lists of sites.
+type voteList struct {
+	title  string
+	places []domain.PollingPlace
+}
+
+// voteLists are the vote page's lists of sites, in tab order. Mail-only
+// elections lead with drop-off locations and leave out polling places if
+// there are none.
+func (m model) voteLists() []voteList {
+	polling := voteList{"Polling Locations", m.electionData.PollingLocations}
+	early := voteList{"Early Voting Sites", m.electionData.EarlyVoteSites}
+	dropOff := voteList{"Drop Off Locations", m.electionData.DropOffLocations}
+	if !m.electionData.MailOnly {
+		return []voteList{polling, early, dropOff}
+	}
+	lists := []voteList{dropOff, early}
+	if len(polling.places) > 0 {
+		lists = append(lists, polling)
+	}
+	return lists
+}
+
+// siteOrder is the order places are listed in, as indexes into places:
+// expired ones are left out unless showExpired is set, in which case they
+// come last.
+func (m model) siteOrder(places []domain.PollingPlace) []int {
+	var order, expired []int
+	for i, p := range places {
+		if !m.isExpired(p) {
+			order = append(order, i)
+		} else if m.showExpired {
+			expired = append(expired, i)
+		}
+	}
+	return append(order, expired...)
+}
+
+// siteItems lists places in siteOrder.
+func (m model) siteItems(places []domain.PollingPlace) []list.Item {
+	items := []list.Item{}
+	for _, i := range m.siteOrder(places) {
+		if p := places[i]; m.isExpired(p) {
+			items = append(items, expiredPlace{PollingPlace: p, closed: m.tf("Closed %s", relativeDay(p.EndDate, m.now(), m.locale))})
+		} else {
+			items = append(items, p)
+		}
+	}
+	return items
+}
+
+// setVoteListItems refills the vote page's lists after showExpired changes.
+func (m model) setVoteListItems() tea.Cmd {
+	var cmds []tea.Cmd
+	for i, l := range m.voteLists() {
+		cmds = append(cmds, m.lm.SetItems(i, m.siteItems(l.places)))
+	}
+	return tea.Batch(cmds...)
+}
+
+func (m model) InitVotePageListManager() *listManager.ListManager {
+	var items [][]list.Item
+	var titles []string
+	for _, l := range m.voteLists() {
+		items = append(items, m.siteItems(l.places))
+		titles = append(titles, m.t(l.title))
+	}
+
+	lm := listManager.InitListManager(items, titles, m.listWidth(), m.voteListHeight())
+	lm.SetDelegate(m.newSiteDelegate())
+	lm.SetFilteringEnabled(false)
+	lm.Apply(func(l *list.Model) {
+		translateList(l, m.locale)
+		m.theme.styleList(l)
+		withFooter(l)
+		m.plainList(l, m.newSiteDelegate())
+	})
+	return lm
 }
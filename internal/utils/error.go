@@ -5,7 +5,8 @@ import "fmt"
 // Updated ErrMsg to include HTTPStatusCode for better error context
 type ErrMsg struct {
 	Err            error
-	HTTPStatusCode int // New field to capture the HTTP status code
+	HTTPStatusCode int    // New field to capture the HTTP status code
+	Reason         string // Machine-readable reason from the API's error body, e.g. "parseError"
 }
 
 func (e ErrMsg) Error() string {